@@ -24,6 +24,9 @@ func runMCP(_ *cobra.Command, _ []string) error {
 		"garmin",
 		"1.0.0",
 		server.WithToolCapabilities(true),
+		server.WithInstructions("Use get_daily_summary for general questions about a day (\"how was my day?\"); "+
+			"it covers steps, calories, floors, resting heart rate, stress, body battery and intensity minutes. "+
+			"Use get_current_date to resolve relative dates."),
 	)
 
 	// Register all tools from the endpoint registry
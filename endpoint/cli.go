@@ -2,6 +2,7 @@
 package endpoint
 
 import (
+	"bufio"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,6 +20,8 @@ type CLIGenerator struct {
 	registry *Registry
 	client   any
 	output   io.Writer
+	input    io.Reader // answers to confirmation prompts
+	prompt   io.Writer // confirmation prompts, kept out of the JSON output
 }
 
 // NewCLIGenerator creates a new CLI generator.
@@ -26,6 +29,8 @@ func NewCLIGenerator(registry *Registry) *CLIGenerator {
 	return &CLIGenerator{
 		registry: registry,
 		output:   os.Stdout,
+		input:    os.Stdin,
+		prompt:   os.Stderr,
 	}
 }
 
@@ -39,6 +44,11 @@ func (g *CLIGenerator) SetOutput(w io.Writer) {
 	g.output = w
 }
 
+// SetInput sets the reader confirmation answers are read from (for testing).
+func (g *CLIGenerator) SetInput(r io.Reader) {
+	g.input = r
+}
+
 // GenerateCommands creates all cobra commands from the registry.
 func (g *CLIGenerator) GenerateCommands() []*cobra.Command {
 	commandGroups := make(map[string][]*Endpoint)
@@ -147,6 +157,10 @@ func (g *CLIGenerator) addFlags(cmd *cobra.Command, ep *Endpoint) {
 	if ep.RawOutput {
 		cmd.Flags().StringP("output", "o", "", "Output file path")
 	}
+
+	if ep.Confirm != nil {
+		cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt")
+	}
 }
 
 func (g *CLIGenerator) createRunFunc(ep *Endpoint) func(*cobra.Command, []string) error {
@@ -164,6 +178,12 @@ func (g *CLIGenerator) createRunFunc(ep *Endpoint) func(*cobra.Command, []string
 			handlerArgs.Body = body
 		}
 
+		if ep.Confirm != nil {
+			if err := g.confirm(cmd, ep.Confirm(handlerArgs)); err != nil {
+				return err
+			}
+		}
+
 		result, err := ep.Handler(cmd.Context(), g.client, handlerArgs)
 		if err != nil {
 			return err
@@ -261,6 +281,28 @@ func (g *CLIGenerator) parseArgs(cmd *cobra.Command, args []string, ep *Endpoint
 	return handlerArgs, nil
 }
 
+var errAborted = errors.New("aborted")
+
+// confirm asks the user to confirm a command, unless --yes was given.
+func (g *CLIGenerator) confirm(cmd *cobra.Command, question string) error {
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return nil
+	}
+
+	fmt.Fprintf(g.prompt, "%s [y/N]: ", question)
+	answer, err := bufio.NewReader(g.input).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("read confirmation: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	default:
+		return errAborted
+	}
+}
+
 var errNoJSONBody = errors.New("no JSON body provided (use --json, --file, or pipe to stdin)")
 
 func (g *CLIGenerator) parseBody(cmd *cobra.Command, ep *Endpoint) (any, error) {
@@ -4,7 +4,10 @@ package endpoint
 import (
 	"bytes"
 	"context"
+	"errors"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -309,3 +312,65 @@ func TestCLIGenerator_Aliases(t *testing.T) {
 		t.Errorf("Aliases = %v, want [device dev]", cmd.Aliases)
 	}
 }
+
+func TestCLIGenerator_Confirm(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		input      string
+		wantCalled bool
+	}{
+		{"answer yes", []string{"delete", "42"}, "y\n", true},
+		{"answer no", []string{"delete", "42"}, "n\n", false},
+		{"no answer", []string{"delete", "42"}, "", false},
+		{"yes flag", []string{"delete", "42", "--yes"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			var question string
+			r := NewRegistry()
+			r.Register(Endpoint{
+				Name:       "DeleteThing",
+				CLICommand: "delete",
+				Params: []Param{
+					{Name: "id", Type: ParamTypeInt, Required: true, Description: "Thing ID"},
+				},
+				Confirm: func(args *HandlerArgs) string {
+					question = "Delete thing " + strconv.Itoa(args.Int("id")) + "?"
+					return question
+				},
+				Handler: func(_ context.Context, _ any, _ *HandlerArgs) (any, error) {
+					called = true
+					return map[string]string{"status": "success"}, nil
+				},
+			})
+
+			gen := NewCLIGenerator(r)
+			gen.SetOutput(&bytes.Buffer{})
+			gen.SetInput(strings.NewReader(tt.input))
+			gen.prompt = &bytes.Buffer{}
+
+			root := &cobra.Command{Use: "test", SilenceUsage: true, SilenceErrors: true}
+			for _, cmd := range gen.GenerateCommands() {
+				root.AddCommand(cmd)
+			}
+			root.SetArgs(tt.args)
+			err := root.Execute()
+
+			if called != tt.wantCalled {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCalled)
+			}
+			if !tt.wantCalled && !errors.Is(err, errAborted) {
+				t.Errorf("Execute() error = %v, want errAborted", err)
+			}
+			if tt.wantCalled && err != nil {
+				t.Errorf("Execute() error = %v", err)
+			}
+			if question != "Delete thing 42?" {
+				t.Errorf("question = %q, want %q", question, "Delete thing 42?")
+			}
+		})
+	}
+}
@@ -3,11 +3,64 @@ package definitions
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/llehouerou/go-garmin"
 	"github.com/llehouerou/go-garmin/endpoint"
 )
 
+// activityTimeFormats are the accepted layouts for manual activity start times.
+var activityTimeFormats = []string{"2006-01-02 15:04", "2006-01-02 15:04:05"}
+
+// parseActivityStart parses a manual activity start time (YYYY-MM-DD HH:MM[:SS]).
+func parseActivityStart(s string) (time.Time, error) {
+	for _, layout := range activityTimeFormats {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid start time: %q (expected YYYY-MM-DD HH:MM[:SS])", s)
+}
+
+// parseManualActivityArgs builds a manual activity from the create flags. Without
+// --start, the activity ends at now, as a wall-clock time in --timezone if given.
+func parseManualActivityArgs(args *endpoint.HandlerArgs, now time.Time) (*garmin.ManualActivity, error) {
+	duration, err := time.ParseDuration(args.String("duration"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration: %w", err)
+	}
+	activity := &garmin.ManualActivity{
+		Name:        args.String("name"),
+		TypeKey:     args.String("type"),
+		Start:       now.Add(-duration),
+		TimeZone:    args.String("timezone"),
+		Duration:    duration,
+		Description: args.String("description"),
+		Privacy:     garmin.ActivityPrivacy(args.String("privacy")),
+	}
+	if activity.TimeZone != "" {
+		loc, err := time.LoadLocation(activity.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone: %w", err)
+		}
+		activity.Start = activity.Start.In(loc)
+	}
+	if s := args.String("start"); s != "" {
+		if activity.Start, err = parseActivityStart(s); err != nil {
+			return nil, err
+		}
+	}
+	if s := args.String("distance_km"); s != "" {
+		km, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid distance: %q", s)
+		}
+		activity.Distance = km * 1000
+	}
+	return activity, nil
+}
+
 // ActivityEndpoints defines all activity-related endpoints.
 var ActivityEndpoints = []endpoint.Endpoint{
 	{
@@ -367,4 +420,117 @@ var ActivityEndpoints = []endpoint.Endpoint{
 			return client.Activities.GetGear(ctx, int64(args.Int("activity_id")))
 		},
 	},
+	{
+		Name:       "CreateActivity",
+		Service:    "Activities",
+		Cassette:   "none",
+		Path:       "/activity-service/activity",
+		HTTPMethod: "POST",
+		Params: []endpoint.Param{
+			{Name: "name", Type: endpoint.ParamTypeString, Required: true, Description: "Activity name"},
+			{Name: "type", Type: endpoint.ParamTypeString, Required: true, Description: "Activity type key, e.g. running or strength_training (see activity types)"},
+			{Name: "duration", Type: endpoint.ParamTypeString, Required: true, Description: "Duration, e.g. 45m or 1h05m30s"},
+			{Name: "start", Type: endpoint.ParamTypeString, Required: false, Description: "Local start time (YYYY-MM-DD HH:MM, defaults to now minus the duration)"},
+			{Name: "distance_km", Type: endpoint.ParamTypeString, Required: false, Description: "Distance in kilometers"},
+			{Name: "description", Type: endpoint.ParamTypeString, Required: false, Description: "Activity description"},
+			{Name: "privacy", Type: endpoint.ParamTypeString, Required: false, Description: "Privacy: public, subscribers (connections), groups or private"},
+			{Name: "timezone", Type: endpoint.ParamTypeString, Required: false, Description: "IANA time zone of the start time (defaults to the profile time zone)"},
+		},
+		CLICommand:    "activities",
+		CLISubcommand: "create",
+		MCPTool:       "create_activity",
+		Short:         "Create a manual activity",
+		Long:          "Create an activity that was not recorded by a device, such as a gym session or a run without a watch",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			activity, err := parseManualActivityArgs(args, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			return client.Activities.Create(ctx, activity)
+		},
+	},
+	{
+		Name:       "UpdateActivity",
+		Service:    "Activities",
+		Cassette:   "none",
+		Path:       "/activity-service/activity/{activityId}",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "activity_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The activity ID"},
+			{Name: "name", Type: endpoint.ParamTypeString, Required: false, Description: "New activity name"},
+			{Name: "description", Type: endpoint.ParamTypeString, Required: false, Description: "New activity description"},
+			{Name: "type", Type: endpoint.ParamTypeString, Required: false, Description: "New activity type key, e.g. running (see activity types)"},
+			{Name: "privacy", Type: endpoint.ParamTypeString, Required: false, Description: "New privacy: public, subscribers (connections), groups or private"},
+			{Name: "event_type", Type: endpoint.ParamTypeString, Required: false, Description: "New event type key, e.g. race, training or uncategorized"},
+		},
+		CLICommand:    "activities",
+		CLISubcommand: "update",
+		MCPTool:       "update_activity",
+		Short:         "Update an activity",
+		Long:          "Rename an activity or change its description, type, privacy or event type. Only the given fields are changed.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			activityID := int64(args.Int("activity_id"))
+			update := &garmin.ActivityUpdate{}
+			if args.HasParam("name") {
+				name := args.String("name")
+				update.Name = &name
+			}
+			if args.HasParam("description") {
+				description := args.String("description")
+				update.Description = &description
+			}
+			if args.HasParam("type") {
+				typeKey := args.String("type")
+				update.TypeKey = &typeKey
+			}
+			if args.HasParam("privacy") {
+				privacy := garmin.ActivityPrivacy(args.String("privacy"))
+				update.Privacy = &privacy
+			}
+			if args.HasParam("event_type") {
+				eventType := args.String("event_type")
+				update.EventType = &eventType
+			}
+			if err := client.Activities.Update(ctx, activityID, update); err != nil {
+				return nil, err
+			}
+			return client.Activities.Get(ctx, activityID)
+		},
+	},
+	{
+		Name:       "DeleteActivity",
+		Service:    "Activities",
+		Cassette:   "none",
+		Path:       "/activity-service/activity/{activityId}",
+		HTTPMethod: "DELETE",
+		Params: []endpoint.Param{
+			{Name: "activity_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The activity ID"},
+		},
+		CLICommand:    "activities",
+		CLISubcommand: "delete",
+		MCPTool:       "delete_activity",
+		Short:         "Delete an activity",
+		Long:          "Permanently delete an activity. The CLI asks for confirmation unless --yes is given.",
+		Confirm: func(args *endpoint.HandlerArgs) string {
+			return fmt.Sprintf("Permanently delete activity %d?", args.Int("activity_id"))
+		},
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			if err := client.Activities.Delete(ctx, int64(args.Int("activity_id"))); err != nil {
+				return nil, err
+			}
+			return map[string]string{"status": "success"}, nil
+		},
+	},
 }
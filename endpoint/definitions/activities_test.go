@@ -0,0 +1,65 @@
+// endpoint/definitions/activities_test.go
+package definitions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+func TestParseManualActivityArgs_DefaultStartInTimeZone(t *testing.T) {
+	args := &endpoint.HandlerArgs{Params: map[string]any{
+		"name":     "Gym",
+		"type":     "strength_training",
+		"duration": "45m",
+		"timezone": "Asia/Tokyo",
+	}}
+	now := time.Date(2026, 1, 27, 12, 0, 0, 0, time.UTC)
+
+	activity, err := parseManualActivityArgs(args, now)
+	if err != nil {
+		t.Fatalf("parseManualActivityArgs failed: %v", err)
+	}
+	// 11:15 UTC is 20:15 in Tokyo
+	if got := activity.Start.Format("2006-01-02 15:04"); got != "2026-01-27 20:15" {
+		t.Errorf("Start = %s, want 2026-01-27 20:15", got)
+	}
+	if activity.Duration != 45*time.Minute {
+		t.Errorf("Duration = %v, want 45m", activity.Duration)
+	}
+}
+
+func TestParseManualActivityArgs_ExplicitStart(t *testing.T) {
+	args := &endpoint.HandlerArgs{Params: map[string]any{
+		"duration":    "1h",
+		"start":       "2026-01-26 07:30",
+		"timezone":    "Asia/Tokyo",
+		"distance_km": "10.5",
+	}}
+
+	activity, err := parseManualActivityArgs(args, time.Now())
+	if err != nil {
+		t.Fatalf("parseManualActivityArgs failed: %v", err)
+	}
+	if got := activity.Start.Format("2006-01-02 15:04"); got != "2026-01-26 07:30" {
+		t.Errorf("Start = %s, want 2026-01-26 07:30", got)
+	}
+	if activity.Distance != 10500 {
+		t.Errorf("Distance = %v, want 10500", activity.Distance)
+	}
+}
+
+func TestParseManualActivityArgs_Invalid(t *testing.T) {
+	tests := []map[string]any{
+		{"duration": "soon"},
+		{"duration": "45m", "timezone": "Mars/Olympus"},
+		{"duration": "45m", "start": "yesterday"},
+		{"duration": "45m", "distance_km": "far"},
+	}
+	for _, params := range tests {
+		if _, err := parseManualActivityArgs(&endpoint.HandlerArgs{Params: params}, time.Now()); err == nil {
+			t.Errorf("expected error for %v", params)
+		}
+	}
+}
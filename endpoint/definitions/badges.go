@@ -0,0 +1,166 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// defaultChallengeLimit bounds the number of challenges returned when no limit is given.
+const defaultChallengeLimit = 50
+
+// collect gathers up to limit items from a paginated iterator.
+func collect[T any](seq iter.Seq2[T, error], limit int) ([]T, error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if len(items) >= limit {
+			break
+		}
+	}
+	return items, nil
+}
+
+// challengeLimitParam is the optional limit param shared by challenge endpoints.
+var challengeLimitParam = endpoint.Param{
+	Name:        "limit",
+	Type:        endpoint.ParamTypeInt,
+	Required:    false,
+	Description: "Maximum number of challenges to return (defaults to 50)",
+}
+
+// BadgeEndpoints defines all badge and challenge API endpoints.
+var BadgeEndpoints = []endpoint.Endpoint{
+	{
+		Name:          "GetEarnedBadges",
+		Service:       "Badges",
+		Cassette:      "badges",
+		Path:          "/badge-service/badge/earned",
+		HTTPMethod:    "GET",
+		CLICommand:    "badges",
+		CLISubcommand: "earned",
+		MCPTool:       "get_earned_badges",
+		Short:         "Get earned badges",
+		Long:          "Get all badges earned by the user with earned date, count and points",
+		Handler: func(ctx context.Context, c any, _ *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Badges.GetEarned(ctx)
+		},
+	},
+	{
+		Name:          "GetAvailableBadges",
+		Service:       "Badges",
+		Cassette:      "badges",
+		Path:          "/badge-service/badge/available?showExclusiveBadge=true",
+		HTTPMethod:    "GET",
+		CLICommand:    "badges",
+		CLISubcommand: "available",
+		MCPTool:       "get_available_badges",
+		Short:         "Get available badges",
+		Long:          "Get all badges the user can still earn, including progress toward each badge",
+		Handler: func(ctx context.Context, c any, _ *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Badges.GetAvailable(ctx)
+		},
+	},
+	{
+		Name:       "ListBadgeChallenges",
+		Service:    "Badges",
+		Cassette:   "badges",
+		Path:       "/badgechallenge-service/badgeChallenge/{status}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "status", Type: endpoint.ParamTypeString, Required: false, Description: "Challenge status: available, completed, or non-completed (defaults to non-completed)"},
+			challengeLimitParam,
+		},
+		CLICommand:    "badges",
+		CLISubcommand: "challenges",
+		MCPTool:       "list_badge_challenges",
+		Short:         "List badge challenges",
+		Long:          "List badge challenges by status: available to join, completed, or joined but not completed yet",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			limit := args.IntOrDefault("limit", defaultChallengeLimit)
+			if limit <= 0 {
+				limit = defaultChallengeLimit
+			}
+			switch status := args.String("status"); status {
+			case "", "non-completed":
+				return collect(client.Badges.NonCompletedChallenges(ctx), limit)
+			case "available":
+				return collect(client.Badges.AvailableChallenges(ctx), limit)
+			case "completed":
+				return collect(client.Badges.CompletedChallenges(ctx), limit)
+			default:
+				return nil, fmt.Errorf("invalid challenge status: %s (expected available, completed, or non-completed)", status)
+			}
+		},
+	},
+	{
+		Name:       "ListVirtualChallenges",
+		Service:    "Badges",
+		Cassette:   "badges",
+		Path:       "/badgechallenge-service/virtualChallenge/inProgress",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			challengeLimitParam,
+		},
+		CLICommand:    "badges",
+		CLISubcommand: "virtual-challenges",
+		MCPTool:       "list_virtual_challenges",
+		Short:         "List in-progress virtual challenges",
+		Long:          "List virtual challenges currently in progress with progress and target values",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			limit := args.IntOrDefault("limit", defaultChallengeLimit)
+			if limit <= 0 {
+				limit = defaultChallengeLimit
+			}
+			return collect(client.Badges.InProgressVirtualChallenges(ctx), limit)
+		},
+	},
+	{
+		Name:       "ListAdHocChallenges",
+		Service:    "Badges",
+		Cassette:   "badges",
+		Path:       "/adhocchallenge-service/adHocChallenge/historical",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			challengeLimitParam,
+		},
+		CLICommand:    "badges",
+		CLISubcommand: "adhoc-challenges",
+		MCPTool:       "list_adhoc_challenges",
+		Short:         "List historical ad-hoc challenges",
+		Long:          "List past ad-hoc challenges with connections, including ranking and player count",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			limit := args.IntOrDefault("limit", defaultChallengeLimit)
+			if limit <= 0 {
+				limit = defaultChallengeLimit
+			}
+			return collect(client.Badges.HistoricalAdHocChallenges(ctx), limit)
+		},
+	},
+}
@@ -3,11 +3,28 @@ package definitions
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strconv"
 
 	"github.com/llehouerou/go-garmin"
 	"github.com/llehouerou/go-garmin/endpoint"
 )
 
+// heartRateZonesBodyConfig provides documentation for the heart rate zones body.
+var heartRateZonesBodyConfig = &endpoint.BodyConfig{
+	Type: reflect.TypeFor[garmin.HeartRateZones](),
+	Description: `JSON array of heart rate zones, one object per sport to change. Sports not given keep their zones.
+
+Fields of a zone:
+- sport (required): DEFAULT, RUNNING or CYCLING
+- trainingMethod (required): e.g. HR_MAX or HR_RESERVE
+- zone1Floor to zone5Floor (required): lower bound of each zone in bpm, strictly increasing
+- maxHeartRateUsed (required): max heart rate in bpm, above zone5Floor
+- restingHeartRateUsed: resting heart rate in bpm, required for HR_RESERVE and below zone1Floor
+- lactateThresholdHeartRateUsed: lactate threshold heart rate in bpm`,
+	Example: `[{"sport": "RUNNING", "trainingMethod": "HR_MAX", "zone1Floor": 95, "zone2Floor": 114, "zone3Floor": 133, "zone4Floor": 152, "zone5Floor": 171, "maxHeartRateUsed": 190}]`,
+}
+
 // BiometricEndpoints defines all biometric-related endpoints.
 var BiometricEndpoints = []endpoint.Endpoint{
 	{
@@ -158,4 +175,89 @@ var BiometricEndpoints = []endpoint.Endpoint{
 			return client.Biometric.GetFTPRange(ctx, start, end)
 		},
 	},
+	{
+		Name:          "SetHeartRateZones",
+		Service:       "Biometric",
+		Cassette:      "none",
+		Path:          "/biometric-service/heartRateZones",
+		HTTPMethod:    "PUT",
+		Body:          heartRateZonesBodyConfig,
+		CLICommand:    "biometric",
+		CLISubcommand: "set-hr-zones",
+		MCPTool:       "set_heart_rate_zones",
+		Short:         "Set heart rate zones",
+		Long:          "Replace the heart rate zones of one or more sports. Zones are checked before being sent: floors must be strictly increasing and below the max heart rate. Use --file to read from a file, --json to pass inline JSON, or pipe JSON to stdin.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			zones, ok := args.Body.(*garmin.HeartRateZones)
+			if !ok {
+				return nil, fmt.Errorf("invalid heart rate zones body type: %T", args.Body)
+			}
+			if err := client.Biometric.SetHeartRateZones(ctx, zones.Zones...); err != nil {
+				return nil, err
+			}
+			return client.Biometric.GetHeartRateZones(ctx)
+		},
+	},
+	{
+		Name:       "SetFTP",
+		Service:    "Biometric",
+		Cassette:   "none",
+		Path:       "/userprofile-service/userprofile/user-settings",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "watts", Type: endpoint.ParamTypeInt, Required: true, Description: "Cycling Functional Threshold Power in watts"},
+		},
+		CLICommand:    "biometric",
+		CLISubcommand: "set-ftp",
+		MCPTool:       "set_cycling_ftp",
+		Short:         "Set cycling FTP",
+		Long:          "Set the cycling Functional Threshold Power and turn off its automatic detection",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			if err := client.Biometric.SetFTP(ctx, args.Int("watts")); err != nil {
+				return nil, err
+			}
+			return client.Biometric.GetCyclingFTP(ctx)
+		},
+	},
+	{
+		Name:       "SetLactateThreshold",
+		Service:    "Biometric",
+		Cassette:   "none",
+		Path:       "/userprofile-service/userprofile/user-settings",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "heart_rate", Type: endpoint.ParamTypeInt, Required: true, Description: "Lactate threshold heart rate in bpm"},
+			{Name: "speed", Type: endpoint.ParamTypeString, Required: false, Description: "Lactate threshold speed, in the unit returned by the lactate threshold (left unchanged if not given)"},
+		},
+		CLICommand:    "biometric",
+		CLISubcommand: "set-lactate-threshold",
+		MCPTool:       "set_lactate_threshold",
+		Short:         "Set lactate threshold",
+		Long:          "Set the lactate threshold heart rate, and optionally speed, and turn off its automatic detection",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			var speed float64
+			if s := args.String("speed"); s != "" {
+				var err error
+				if speed, err = strconv.ParseFloat(s, 64); err != nil {
+					return nil, fmt.Errorf("invalid speed: %q", s)
+				}
+			}
+			if err := client.Biometric.SetLactateThreshold(ctx, args.Int("heart_rate"), speed); err != nil {
+				return nil, err
+			}
+			return client.Biometric.GetLatestLactateThreshold(ctx)
+		},
+	},
 }
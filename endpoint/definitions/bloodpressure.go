@@ -0,0 +1,158 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// bloodPressureImportResult is the result of importing readings from a CSV file.
+type bloodPressureImportResult struct {
+	Imported     int                                `json:"imported"`
+	DryRun       bool                               `json:"dryRun"`
+	Readings     []garmin.BloodPressureReading      `json:"readings,omitempty"`
+	Measurements []*garmin.BloodPressureMeasurement `json:"measurements,omitempty"`
+}
+
+// BloodPressureEndpoints defines all blood pressure-related endpoints.
+var BloodPressureEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "GetBloodPressureRange",
+		Service:    "BloodPressure",
+		Cassette:   "bloodpressure",
+		Path:       "/bloodpressure-service/bloodpressure/range/{start}/{end}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for blood pressure readings"},
+		},
+		CLICommand:    "bloodpressure",
+		CLISubcommand: "range",
+		MCPTool:       "get_blood_pressure",
+		Short:         "Get blood pressure readings for a date range",
+		Long:          "Get blood pressure readings for a date range with daily high, low and average values",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			start := args.Date("start")
+			end := args.Date("end")
+			return client.BloodPressure.GetRange(ctx, start, end)
+		},
+	},
+	{
+		Name:       "LogBloodPressure",
+		Service:    "BloodPressure",
+		Cassette:   "none",
+		Path:       "/bloodpressure-service/bloodpressure",
+		HTTPMethod: "POST",
+		Params: []endpoint.Param{
+			{Name: "systolic", Type: endpoint.ParamTypeInt, Required: true, Description: "Systolic pressure in mmHg"},
+			{Name: "diastolic", Type: endpoint.ParamTypeInt, Required: true, Description: "Diastolic pressure in mmHg"},
+			{Name: "pulse", Type: endpoint.ParamTypeInt, Required: true, Description: "Pulse in beats per minute"},
+			{Name: "timestamp", Type: endpoint.ParamTypeString, Required: false, Description: "Local time of the reading (YYYY-MM-DD HH:MM, defaults to now)"},
+			{Name: "notes", Type: endpoint.ParamTypeString, Required: false, Description: "Optional notes"},
+		},
+		CLICommand:    "bloodpressure",
+		CLISubcommand: "log",
+		MCPTool:       "log_blood_pressure",
+		Short:         "Log a blood pressure reading",
+		Long:          "Log a manual blood pressure reading with systolic and diastolic pressure (mmHg) and pulse (bpm)",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			timestamp := time.Now()
+			if s := args.String("timestamp"); s != "" {
+				var err error
+				if timestamp, err = garmin.ParseBloodPressureTimestamp(s); err != nil {
+					return nil, err
+				}
+			}
+			return client.BloodPressure.Log(ctx, args.Int("systolic"), args.Int("diastolic"), args.Int("pulse"), timestamp, args.String("notes"))
+		},
+	},
+	{
+		Name:       "ImportBloodPressure",
+		Service:    "BloodPressure",
+		Cassette:   "none",
+		Path:       "/bloodpressure-service/bloodpressure",
+		HTTPMethod: "POST",
+		Params: []endpoint.Param{
+			{Name: "file", Type: endpoint.ParamTypeString, Required: true, Description: "Path to a CSV file with columns timestamp, systolic, diastolic, pulse and optionally notes"},
+			{Name: "dry_run", Type: endpoint.ParamTypeBool, Required: false, Description: "Parse and validate the file without logging readings"},
+		},
+		CLICommand:    "bloodpressure",
+		CLISubcommand: "import",
+		MCPTool:       "import_blood_pressure",
+		Short:         "Import blood pressure readings from CSV",
+		Long: `Log blood pressure readings from a CSV file, e.g. to backfill readings kept in a spreadsheet.
+The first row must be a header with the columns timestamp, systolic, diastolic, pulse and optionally notes.
+Timestamps are local times formatted as YYYY-MM-DD HH:MM[:SS]. All rows are validated before any reading is logged.
+If logging stops partway, the error names the failed reading; the readings before it are already logged
+and must be removed from the file before retrying to avoid duplicates.`,
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			f, err := os.Open(args.String("file"))
+			if err != nil {
+				return nil, fmt.Errorf("open file: %w", err)
+			}
+			defer f.Close()
+
+			readings, err := garmin.ParseBloodPressureCSV(f)
+			if err != nil {
+				return nil, fmt.Errorf("parse CSV: %w", err)
+			}
+			if args.Bool("dry_run") {
+				return &bloodPressureImportResult{DryRun: true, Readings: readings}, nil
+			}
+
+			result := &bloodPressureImportResult{}
+			for i, r := range readings {
+				m, err := client.BloodPressure.Log(ctx, r.Systolic, r.Diastolic, r.Pulse, r.Timestamp, r.Notes)
+				if err != nil {
+					// Readings before i are already logged; report where to resume
+					return result, fmt.Errorf("log reading %d of %d at %s (%d earlier readings imported, resume from reading %d): %w",
+						i+1, len(readings), r.Timestamp.Format("2006-01-02 15:04"), result.Imported, i+1, err)
+				}
+				result.Imported++
+				result.Measurements = append(result.Measurements, m)
+			}
+			return result, nil
+		},
+	},
+	{
+		Name:       "DeleteBloodPressure",
+		Service:    "BloodPressure",
+		Cassette:   "none",
+		Path:       "/bloodpressure-service/bloodpressure/{date}/{version}",
+		HTTPMethod: "DELETE",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: true, Description: "Date of the reading (YYYY-MM-DD)"},
+			{Name: "version", Type: endpoint.ParamTypeInt, Required: true, Description: "Version of the reading, as returned by the range endpoint"},
+		},
+		CLICommand:    "bloodpressure",
+		CLISubcommand: "delete",
+		MCPTool:       "delete_blood_pressure",
+		Short:         "Delete a blood pressure reading",
+		Long:          "Delete a blood pressure reading identified by its date and version",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			if err := client.BloodPressure.Delete(ctx, args.Date("date"), int64(args.Int("version"))); err != nil {
+				return nil, err
+			}
+			return map[string]string{"status": "success"}, nil
+		},
+	},
+}
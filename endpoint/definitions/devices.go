@@ -2,12 +2,49 @@ package definitions
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"github.com/llehouerou/go-garmin"
 	"github.com/llehouerou/go-garmin/endpoint"
 )
 
+// deviceSettingsChanges is a partial device settings JSON object, applied over
+// the current settings of the device.
+type deviceSettingsChanges struct {
+	data json.RawMessage
+}
+
+// UnmarshalJSON keeps the JSON object to apply it later.
+func (d *deviceSettingsChanges) UnmarshalJSON(data []byte) error {
+	d.data = append(json.RawMessage(nil), data...)
+	return nil
+}
+
+// apply applies the changes to the settings, including settings that
+// garmin.DeviceSettings does not model. A list of alarms replaces the current
+// alarms; each given alarm keeps the settings not given of the current alarm
+// with the same alarmId.
+func (d *deviceSettingsChanges) apply(settings *garmin.DeviceSettings) error {
+	if err := settings.Apply(d.data); err != nil {
+		return fmt.Errorf("invalid device settings changes: %w", err)
+	}
+	return nil
+}
+
+// deviceSettingsBodyConfig provides documentation for the device settings changes.
+var deviceSettingsBodyConfig = &endpoint.BodyConfig{
+	Type: reflect.TypeFor[deviceSettingsChanges](),
+	Description: `JSON object with the device settings to change, using the field names of the device settings. Settings not given are left unchanged, including nested ones; a list of alarms replaces all the alarms of the device, each listed alarm keeping the settings not given of the current alarm with the same alarmId.
+
+Common fields:
+- activityTracking (object): activityTrackingEnabled, moveAlertEnabled, moveBarEnabled, pulseOxSleepTrackingEnabled, pulseOxAcclimationEnabled, highHrAlertEnabled, highHrAlertThreshold (bpm), lowHrAlertEnabled, lowHrAlertThreshold (bpm)
+- alarms (array): alarmId, alarmMode (ON, OFF), alarmTime (minutes after midnight), alarmDays (e.g. ["MONDAY", "TUESDAY"] or ["ONCE"]), alarmSound (e.g. TONE_AND_VIBRATION), backlight (ON, OFF)
+- timeFormat, dateFormat, measurementUnits, startOfWeek`,
+	Example: `{"activityTracking": {"moveAlertEnabled": false, "highHrAlertEnabled": true, "highHrAlertThreshold": 170}}`,
+}
+
 // DeviceEndpoints defines all device-related endpoints.
 var DeviceEndpoints = []endpoint.Endpoint{
 	{
@@ -59,4 +96,92 @@ var DeviceEndpoints = []endpoint.Endpoint{
 			return client.Devices.GetSettings(ctx, int64(args.Int("device_id")))
 		},
 	},
+	{
+		Name:          "GetLastUsedDevice",
+		Service:       "Devices",
+		Cassette:      "devices_extra",
+		Path:          "/device-service/deviceservice/mylastused",
+		HTTPMethod:    "GET",
+		CLICommand:    "devices",
+		CLISubcommand: "last-used",
+		MCPTool:       "get_last_used_device",
+		Short:         "Get the last used device",
+		Long:          "Get the device that last synced with Garmin Connect, with its name and the time of the last upload",
+		Handler: func(ctx context.Context, c any, _ *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Devices.GetLastUsed(ctx)
+		},
+	},
+	{
+		Name:       "GetSolarIntake",
+		Service:    "Devices",
+		Cassette:   "devices_extra",
+		Path:       "/web-gateway/solar/{deviceId}/{startDate}/{endDate}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "device_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The device ID"},
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for solar data"},
+		},
+		CLICommand:    "devices",
+		CLISubcommand: "solar",
+		MCPTool:       "get_solar_intake",
+		Short:         "Get solar charging data",
+		Long:          "Get the solar intensity readings of a solar device over a date range and the battery time gained from the sun during activities",
+		DependsOn:     "GetLastUsedDevice",
+		ArgProvider: func(result any) map[string]any {
+			device, ok := result.(*garmin.LastUsedDevice)
+			if !ok || device == nil {
+				return nil
+			}
+			return map[string]any{"device_id": device.UserDeviceID}
+		},
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Devices.GetSolarIntake(ctx, int64(args.Int("device_id")), args.Date("start"), args.Date("end"))
+		},
+	},
+	{
+		Name:       "UpdateDeviceSettings",
+		Service:    "Devices",
+		Cassette:   "none",
+		Path:       "/device-service/deviceservice/device-info/settings/{deviceId}",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "device_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The device ID"},
+		},
+		Body:          deviceSettingsBodyConfig,
+		CLICommand:    "devices",
+		CLISubcommand: "update-settings",
+		MCPTool:       "update_device_settings",
+		Short:         "Update device settings",
+		Long:          "Change settings of a device such as alarms, move alerts, heart rate alerts or pulse ox tracking. Only the given settings are changed; the device applies them at its next sync. Use --file to read from a file, --json to pass inline JSON, or pipe JSON to stdin.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			changes, ok := args.Body.(*deviceSettingsChanges)
+			if !ok {
+				return nil, fmt.Errorf("invalid device settings body type: %T", args.Body)
+			}
+			deviceID := int64(args.Int("device_id"))
+			settings, err := client.Devices.GetSettings(ctx, deviceID)
+			if err != nil {
+				return nil, err
+			}
+			if err := changes.apply(settings); err != nil {
+				return nil, err
+			}
+			if err := client.Devices.UpdateSettings(ctx, deviceID, settings); err != nil {
+				return nil, err
+			}
+			return client.Devices.GetSettings(ctx, deviceID)
+		},
+	},
 }
@@ -0,0 +1,59 @@
+// endpoint/definitions/devices_test.go
+package definitions
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/llehouerou/go-garmin"
+)
+
+func TestDeviceSettingsChangesApply(t *testing.T) {
+	var settings garmin.DeviceSettings
+	current := `{
+		"deviceId": 42,
+		"timeFormat": "time_twenty_four_hr",
+		"activityTracking": {"moveAlertEnabled": true, "highHrAlertEnabled": false},
+		"alarms": [
+			{"alarmId": 1, "alarmMode": "ON", "alarmTime": 420, "alarmDays": ["MONDAY", "TUESDAY"]},
+			{"alarmId": 2, "alarmMode": "ON", "alarmTime": 480, "alarmDays": ["SATURDAY"]}
+		]
+	}`
+	if err := json.Unmarshal([]byte(current), &settings); err != nil {
+		t.Fatalf("Failed to unmarshal settings: %v", err)
+	}
+	settings.SetRaw(json.RawMessage(current))
+
+	var changes deviceSettingsChanges
+	patch := `{"activityTracking": {"moveAlertEnabled": false}, "alarms": [{"alarmId": 3, "alarmMode": "OFF", "alarmTime": 360, "alarmDays": ["ONCE"]}]}`
+	if err := json.Unmarshal([]byte(patch), &changes); err != nil {
+		t.Fatalf("Failed to unmarshal changes: %v", err)
+	}
+	if err := changes.apply(&settings); err != nil {
+		t.Fatalf("apply failed: %v", err)
+	}
+
+	if settings.TimeFormat != "time_twenty_four_hr" {
+		t.Errorf("TimeFormat = %q, want unchanged", settings.TimeFormat)
+	}
+	tracking := settings.ActivityTracking
+	if tracking == nil || tracking.MoveAlertEnabled == nil || *tracking.MoveAlertEnabled {
+		t.Errorf("MoveAlertEnabled = %v, want false", tracking)
+	}
+	if tracking != nil && (tracking.HighHrAlertEnabled == nil || *tracking.HighHrAlertEnabled) {
+		t.Error("expected HighHrAlertEnabled to be left unchanged")
+	}
+	if len(settings.Alarms) != 1 {
+		t.Fatalf("len(Alarms) = %d, want the alarms to be replaced", len(settings.Alarms))
+	}
+	if alarm := settings.Alarms[0]; alarm.AlarmID != 3 || len(alarm.AlarmDays) != 1 || alarm.AlarmDays[0] != "ONCE" {
+		t.Errorf("Alarms[0] = %+v, want alarm 3 on ONCE", alarm)
+	}
+}
+
+func TestDeviceSettingsChangesApplyInvalid(t *testing.T) {
+	changes := deviceSettingsChanges{data: json.RawMessage(`[1, 2]`)}
+	if err := changes.apply(&garmin.DeviceSettings{}); err == nil {
+		t.Error("expected error for changes that are not an object")
+	}
+}
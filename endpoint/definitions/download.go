@@ -0,0 +1,162 @@
+package definitions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// activityListPageSize is the page size used when listing all activities for a bulk download.
+const activityListPageSize = 100
+
+// bulkDownloadSummary is the result of a bulk download.
+type bulkDownloadSummary struct {
+	Downloaded int                         `json:"downloaded"`
+	Skipped    int                         `json:"skipped"`
+	Failed     int                         `json:"failed"`
+	Results    []garmin.BulkDownloadResult `json:"results"`
+}
+
+// parseActivityIDs parses a comma-separated list of activity IDs.
+func parseActivityIDs(s string) ([]int64, error) {
+	var ids []int64
+	for field := range strings.SplitSeq(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid activity ID: %q", field)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// allActivityIDs pages through the activity list and returns every activity ID.
+func allActivityIDs(ctx context.Context, client *garmin.Client) ([]int64, error) {
+	var ids []int64
+	for start := 0; ; start += activityListPageSize {
+		page, err := client.Activities.List(ctx, &garmin.ListOptions{Start: start, Limit: activityListPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for i := range page {
+			ids = append(ids, page[i].ActivityID)
+		}
+		if len(page) < activityListPageSize {
+			return ids, nil
+		}
+	}
+}
+
+// DownloadEndpoints defines all download-related endpoints.
+// They write files to the local disk, so they are not exposed as MCP tools.
+var DownloadEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "DownloadActivityFile",
+		Service:    "Download",
+		Cassette:   "none",
+		Path:       "/download-service/files/activity/{activity_id}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "activity_id", Type: endpoint.ParamTypeInt, Required: true, Description: "Activity ID to download"},
+			{Name: "format", Type: endpoint.ParamTypeString, Required: false, Description: "File format: fit, tcx, gpx, kml or csv (defaults to fit)"},
+			{Name: "output", Type: endpoint.ParamTypeString, Required: false, Description: "Output file path (defaults to <activity_id>.<format>)", CLIOnly: true},
+		},
+		CLICommand:    "download",
+		CLISubcommand: "activity",
+		Short:         "Download an activity file",
+		Long:          "Download an activity to a file, streaming it to disk. FIT downloads are extracted from the original file archive.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			activityID := int64(args.Int("activity_id"))
+			format := garmin.ActivityFormat(strings.ToLower(args.String("format")))
+			if format == "" {
+				format = garmin.ActivityFormatFIT
+			}
+			output := args.String("output")
+			if output == "" {
+				output = format.FileName(activityID)
+			}
+
+			n, err := client.Download.ActivityToFile(ctx, activityID, format, output)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]any{"activityId": activityID, "path": output, "bytes": n}, nil
+		},
+	},
+	{
+		Name:       "BulkDownloadActivities",
+		Service:    "Download",
+		Cassette:   "none",
+		Path:       "/download-service/files/activity/{activity_id}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "ids", Type: endpoint.ParamTypeString, Required: false, Description: "Comma-separated activity IDs (defaults to all activities)"},
+			{Name: "dir", Type: endpoint.ParamTypeString, Required: false, Description: "Destination directory (defaults to the current directory)", CLIOnly: true},
+			{Name: "format", Type: endpoint.ParamTypeString, Required: false, Description: "File format: fit, tcx, gpx, kml or csv (defaults to fit)"},
+			{Name: "concurrency", Type: endpoint.ParamTypeInt, Required: false, Description: "Number of parallel downloads (defaults to 2)"},
+			{Name: "overwrite", Type: endpoint.ParamTypeBool, Required: false, Description: "Re-download activities whose file already exists"},
+		},
+		CLICommand:    "download",
+		CLISubcommand: "bulk",
+		Short:         "Download many activities to a directory",
+		Long: `Download activities to <dir>/<activity_id>.<format>, e.g. to archive a whole account.
+Files that already exist are skipped, so an interrupted download can be resumed by running the same command again.
+Downloads run in parallel but share the client's rate limit.`,
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			ids, err := parseActivityIDs(args.String("ids"))
+			if err != nil {
+				return nil, err
+			}
+			if len(ids) == 0 {
+				if ids, err = allActivityIDs(ctx, client); err != nil {
+					return nil, fmt.Errorf("list activities: %w", err)
+				}
+			}
+			dir := args.String("dir")
+			if dir == "" {
+				dir = "."
+			}
+
+			results, err := client.Download.Bulk(ctx, ids, garmin.BulkDownloadOptions{
+				Dir:         dir,
+				Format:      garmin.ActivityFormat(strings.ToLower(args.String("format"))),
+				Concurrency: args.Int("concurrency"),
+				Overwrite:   args.Bool("overwrite"),
+			})
+			// Per-activity failures are reported in the summary
+			if err != nil && (results == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+				return nil, err
+			}
+
+			summary := &bulkDownloadSummary{Results: results}
+			for i := range results {
+				switch {
+				case results[i].Err != nil:
+					summary.Failed++
+				case results[i].Skipped:
+					summary.Skipped++
+				default:
+					summary.Downloaded++
+				}
+			}
+			return summary, nil
+		},
+	},
+}
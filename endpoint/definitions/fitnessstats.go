@@ -61,6 +61,22 @@ func parseMetrics(metricsStr string) ([]garmin.FitnessMetric, error) {
 	return metrics, nil
 }
 
+// parseAggregation parses an aggregation param, returning def if it is empty.
+// The aggregation must be one of valid.
+func parseAggregation(value string, def garmin.Aggregation, valid ...garmin.Aggregation) (garmin.Aggregation, error) {
+	if value == "" {
+		return def, nil
+	}
+	names := make([]string, len(valid))
+	for i, agg := range valid {
+		if value == string(agg) {
+			return agg, nil
+		}
+		names[i] = string(agg)
+	}
+	return "", fmt.Errorf("invalid aggregation: %s (valid: %s)", value, strings.Join(names, ", "))
+}
+
 // FitnessStatsEndpoints defines all fitness stats-related endpoints.
 var FitnessStatsEndpoints = []endpoint.Endpoint{
 	{
@@ -87,21 +103,10 @@ var FitnessStatsEndpoints = []endpoint.Endpoint{
 				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
 			}
 
-			// Parse aggregation
-			aggregation := garmin.AggregationWeekly
-			if agg := args.String("aggregation"); agg != "" {
-				switch agg {
-				case "daily":
-					aggregation = garmin.AggregationDaily
-				case "weekly":
-					aggregation = garmin.AggregationWeekly
-				case "monthly":
-					aggregation = garmin.AggregationMonthly
-				case "yearly":
-					aggregation = garmin.AggregationYearly
-				default:
-					return nil, fmt.Errorf("invalid aggregation: %s (valid: daily, weekly, monthly, yearly)", agg)
-				}
+			aggregation, err := parseAggregation(args.String("aggregation"), garmin.AggregationWeekly,
+				garmin.AggregationDaily, garmin.AggregationWeekly, garmin.AggregationMonthly, garmin.AggregationYearly)
+			if err != nil {
+				return nil, err
 			}
 
 			// Parse metrics
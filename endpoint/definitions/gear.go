@@ -0,0 +1,307 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// resolveUserProfilePK returns the user_profile_pk param, or fetches it from the
+// current user's social profile when not set.
+func resolveUserProfilePK(ctx context.Context, client *garmin.Client, args *endpoint.HandlerArgs) (int64, error) {
+	if pk := args.Int("user_profile_pk"); pk != 0 {
+		return int64(pk), nil
+	}
+	profile, err := client.UserProfile.GetSocialProfile(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user profile: %w", err)
+	}
+	return profile.ProfileID, nil
+}
+
+// profilePKArgProvider provides the user_profile_pk param from a social profile.
+func profilePKArgProvider(result any) map[string]any {
+	profile, ok := result.(*garmin.SocialProfile)
+	if !ok || profile == nil {
+		return nil
+	}
+	return map[string]any{"user_profile_pk": int(profile.ProfileID)}
+}
+
+// firstGearArgProvider provides the gear_uuid param from a gear list.
+func firstGearArgProvider(result any) map[string]any {
+	gear, ok := result.(*garmin.GearList)
+	if !ok || gear == nil || len(gear.Items) == 0 {
+		return nil
+	}
+	return map[string]any{"gear_uuid": gear.Items[0].UUID}
+}
+
+// GearEndpoints defines all gear-related API endpoints.
+var GearEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "ListGear",
+		Service:    "Gear",
+		Cassette:   "gear",
+		Path:       "/gear-service/gear/filterGear?userProfilePk={userProfilePk}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "user_profile_pk", Type: endpoint.ParamTypeInt, Required: false, Description: "User profile PK (defaults to current user)"},
+		},
+		CLICommand:    "gear",
+		CLISubcommand: "list",
+		MCPTool:       "list_gear",
+		Short:         "List gear",
+		Long:          "List all gear (shoes, bikes, etc.) owned by the user, including retired gear",
+		DependsOn:     "GetSocialProfile",
+		ArgProvider:   profilePKArgProvider,
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			pk, err := resolveUserProfilePK(ctx, client, args)
+			if err != nil {
+				return nil, err
+			}
+			return client.Gear.List(ctx, pk)
+		},
+	},
+	{
+		Name:       "GetGearStats",
+		Service:    "Gear",
+		Cassette:   "gear",
+		Path:       "/gear-service/gear/stats/{gearUUID}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "gear_uuid", Type: endpoint.ParamTypeString, Required: true, Description: "The gear UUID"},
+		},
+		CLICommand:    "gear",
+		CLISubcommand: "stats",
+		MCPTool:       "get_gear_stats",
+		Short:         "Get gear stats",
+		Long:          "Get usage statistics for a piece of gear including total distance (meters) and number of activities",
+		DependsOn:     "ListGear",
+		ArgProvider:   firstGearArgProvider,
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Gear.GetStats(ctx, args.String("gear_uuid"))
+		},
+	},
+	{
+		Name:       "GetGearActivities",
+		Service:    "Gear",
+		Cassette:   "gear",
+		Path:       "/activitylist-service/activities/{gearUUID}/gear",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "gear_uuid", Type: endpoint.ParamTypeString, Required: true, Description: "The gear UUID"},
+			{Name: "start", Type: endpoint.ParamTypeInt, Required: false, Description: "Starting index (0-based, defaults to 0)"},
+			{Name: "limit", Type: endpoint.ParamTypeInt, Required: false, Description: "Maximum number of activities to return (defaults to 20)"},
+		},
+		CLICommand:    "gear",
+		CLISubcommand: "activities",
+		MCPTool:       "get_gear_activities",
+		Short:         "List activities for gear",
+		Long:          "List activities recorded with a piece of gear, with pagination",
+		DependsOn:     "ListGear",
+		ArgProvider:   firstGearArgProvider,
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			opts := &garmin.ListOptions{
+				Start: args.Int("start"),
+				Limit: args.Int("limit"),
+			}
+			activities, err := client.Gear.GetActivities(ctx, args.String("gear_uuid"), opts)
+			if err != nil {
+				return nil, err
+			}
+			items := make([]garmin.ActivityListItem, len(activities))
+			for i := range activities {
+				items[i] = activities[i].ToListItem()
+			}
+			return items, nil
+		},
+	},
+	{
+		Name:       "GetGearDefaults",
+		Service:    "Gear",
+		Cassette:   "gear",
+		Path:       "/gear-service/gear/user/{userProfilePk}/activityTypes",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "user_profile_pk", Type: endpoint.ParamTypeInt, Required: false, Description: "User profile PK (defaults to current user)"},
+		},
+		CLICommand:    "gear",
+		CLISubcommand: "defaults",
+		MCPTool:       "get_gear_defaults",
+		Short:         "Get default gear per activity type",
+		Long:          "Get the gear used by default for each activity type",
+		DependsOn:     "GetSocialProfile",
+		ArgProvider:   profilePKArgProvider,
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			pk, err := resolveUserProfilePK(ctx, client, args)
+			if err != nil {
+				return nil, err
+			}
+			return client.Gear.GetDefaults(ctx, pk)
+		},
+	},
+	{
+		Name:       "SetGearDefault",
+		Service:    "Gear",
+		Cassette:   "none",
+		Path:       "/gear-service/gear/{gearUUID}/activityType/{activityTypeId}/default/true",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "gear_uuid", Type: endpoint.ParamTypeString, Required: true, Description: "The gear UUID"},
+			{Name: "activity_type_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The activity type ID (e.g., 1=running, 2=cycling)"},
+		},
+		CLICommand:    "gear",
+		CLISubcommand: "set-default",
+		MCPTool:       "set_gear_default",
+		Short:         "Set default gear for an activity type",
+		Long:          "Make a piece of gear the default for an activity type, so new activities of that type are linked to it automatically",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			err := client.Gear.SetDefault(ctx, args.String("gear_uuid"), int64(args.Int("activity_type_id")))
+			if err != nil {
+				return nil, err
+			}
+			return map[string]string{"status": "success"}, nil
+		},
+	},
+	{
+		Name:       "RemoveGearDefault",
+		Service:    "Gear",
+		Cassette:   "none",
+		Path:       "/gear-service/gear/{gearUUID}/activityType/{activityTypeId}",
+		HTTPMethod: "DELETE",
+		Params: []endpoint.Param{
+			{Name: "gear_uuid", Type: endpoint.ParamTypeString, Required: true, Description: "The gear UUID"},
+			{Name: "activity_type_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The activity type ID (e.g., 1=running, 2=cycling)"},
+		},
+		CLICommand:    "gear",
+		CLISubcommand: "remove-default",
+		MCPTool:       "remove_gear_default",
+		Short:         "Remove default gear for an activity type",
+		Long:          "Stop using a piece of gear as the default for an activity type",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			err := client.Gear.RemoveDefault(ctx, args.String("gear_uuid"), int64(args.Int("activity_type_id")))
+			if err != nil {
+				return nil, err
+			}
+			return map[string]string{"status": "success"}, nil
+		},
+	},
+	{
+		Name:       "LinkGear",
+		Service:    "Gear",
+		Cassette:   "none",
+		Path:       "/gear-service/gear/link/{gearUUID}/activity/{activityId}",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "gear_uuid", Type: endpoint.ParamTypeString, Required: true, Description: "The gear UUID"},
+			{Name: "activity_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The activity ID"},
+		},
+		CLICommand:    "gear",
+		CLISubcommand: "link",
+		MCPTool:       "link_gear",
+		Short:         "Link gear to an activity",
+		Long:          "Link a piece of gear to an activity so its distance counts toward the gear total",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			err := client.Gear.LinkToActivity(ctx, args.String("gear_uuid"), int64(args.Int("activity_id")))
+			if err != nil {
+				return nil, err
+			}
+			return map[string]string{"status": "success"}, nil
+		},
+	},
+	{
+		Name:       "UnlinkGear",
+		Service:    "Gear",
+		Cassette:   "none",
+		Path:       "/gear-service/gear/unlink/{gearUUID}/activity/{activityId}",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "gear_uuid", Type: endpoint.ParamTypeString, Required: true, Description: "The gear UUID"},
+			{Name: "activity_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The activity ID"},
+		},
+		CLICommand:    "gear",
+		CLISubcommand: "unlink",
+		MCPTool:       "unlink_gear",
+		Short:         "Unlink gear from an activity",
+		Long:          "Remove the link between a piece of gear and an activity",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			err := client.Gear.UnlinkFromActivity(ctx, args.String("gear_uuid"), int64(args.Int("activity_id")))
+			if err != nil {
+				return nil, err
+			}
+			return map[string]string{"status": "success"}, nil
+		},
+	},
+	{
+		Name:       "RetireGear",
+		Service:    "Gear",
+		Cassette:   "none",
+		Path:       "/gear-service/gear/{gearUUID}",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "gear_uuid", Type: endpoint.ParamTypeString, Required: true, Description: "The gear UUID"},
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Retirement date (YYYY-MM-DD, defaults to today)"},
+			{Name: "user_profile_pk", Type: endpoint.ParamTypeInt, Required: false, Description: "User profile PK (defaults to current user)"},
+		},
+		CLICommand:    "gear",
+		CLISubcommand: "retire",
+		MCPTool:       "retire_gear",
+		Short:         "Retire gear",
+		Long:          "Mark a piece of gear as retired so it is no longer offered for new activities",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			pk, err := resolveUserProfilePK(ctx, client, args)
+			if err != nil {
+				return nil, err
+			}
+			gearList, err := client.Gear.List(ctx, pk)
+			if err != nil {
+				return nil, err
+			}
+			gearUUID := args.String("gear_uuid")
+			gear := gearList.Find(gearUUID)
+			if gear == nil {
+				return nil, fmt.Errorf("gear not found: %s", gearUUID)
+			}
+			return client.Gear.Retire(ctx, gear, args.Date("date"))
+		},
+	},
+}
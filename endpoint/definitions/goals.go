@@ -0,0 +1,189 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// goalBodyConfig provides documentation for the goal JSON structure.
+var goalBodyConfig = &endpoint.BodyConfig{
+	Type: reflect.TypeFor[garmin.Goal](),
+	Description: `JSON object representing a goal. Required fields: goalType, goalValue, startDate.
+
+GOAL TYPES (goalType) and units of goalValue:
+  steps=daily steps, weight=grams, distance=meters, duration=seconds, activities=count
+
+Fields:
+- goalType (string, required): One of the goal types above
+- goalValue (number, required): Target value
+- startDate (string, required): Start date (YYYY-MM-DD)
+- endDate (string): End date (YYYY-MM-DD), omit for open-ended goals
+- goalName (string): Optional name
+- activityTypePk (int): Restrict distance/duration/activities goals to an activity type (e.g., 1=running, 2=cycling)`,
+	Example: `{"goalType": "distance", "goalValue": 100000, "startDate": "2026-01-01", "endDate": "2026-01-31", "activityTypePk": 1}`,
+}
+
+// findGoal looks up a goal by ID across active, future and past goals.
+func findGoal(ctx context.Context, client *garmin.Client, goalID int64) (*garmin.Goal, error) {
+	for _, status := range []garmin.GoalStatus{garmin.GoalStatusActive, garmin.GoalStatusFuture, garmin.GoalStatusPast} {
+		goals, err := client.Goals.List(ctx, status)
+		if err != nil {
+			if garmin.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		for i := range goals.Items {
+			if goals.Items[i].ID == goalID {
+				return &goals.Items[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("goal not found: %d", goalID)
+}
+
+// GoalEndpoints defines all goal-related API endpoints.
+var GoalEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "ListGoals",
+		Service:    "Goals",
+		Cassette:   "goals",
+		Path:       "/goal-service/goal/goals?status={status}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "status", Type: endpoint.ParamTypeString, Required: false, Description: "Goal status: active, future, or past (defaults to active)"},
+		},
+		CLICommand:    "goals",
+		CLISubcommand: "list",
+		MCPTool:       "list_goals",
+		Short:         "List goals",
+		Long:          "List step, weight and activity goals by status (active, future, or past)",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			status := garmin.GoalStatus(args.String("status"))
+			if status == "" {
+				status = garmin.GoalStatusActive
+			}
+			return client.Goals.List(ctx, status)
+		},
+	},
+	{
+		Name:       "GetGoalProgress",
+		Service:    "Goals",
+		Cassette:   "goals",
+		Path:       "/goal-service/goal/goals?status={status}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "goal_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The goal ID"},
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to compute progress up to (YYYY-MM-DD, defaults to today)"},
+		},
+		CLICommand:    "goals",
+		CLISubcommand: "progress",
+		MCPTool:       "get_goal_progress",
+		Short:         "Get goal progress",
+		Long:          "Get the progress of a goal computed from weigh-ins (weight goals), recorded activities (distance, duration and activity count goals) or daily steps (steps goals)",
+		DependsOn:     "ListGoals",
+		ArgProvider: func(result any) map[string]any {
+			goals, ok := result.(*garmin.GoalList)
+			if !ok || goals == nil || len(goals.Items) == 0 {
+				return nil
+			}
+			return map[string]any{"goal_id": int(goals.Items[0].ID)}
+		},
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			goal, err := findGoal(ctx, client, int64(args.Int("goal_id")))
+			if err != nil {
+				return nil, err
+			}
+			return client.Goals.GetProgress(ctx, goal, args.Date("date"))
+		},
+	},
+	{
+		Name:          "CreateGoal",
+		Service:       "Goals",
+		Cassette:      "none",
+		Path:          "/goal-service/goal/goal",
+		HTTPMethod:    "POST",
+		Body:          goalBodyConfig,
+		CLICommand:    "goals",
+		CLISubcommand: "create",
+		MCPTool:       "create_goal",
+		Short:         "Create a goal",
+		Long:          "Create a new step, weight or activity goal. Use --file to read from a file, --json to pass inline JSON, or pipe JSON to stdin.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			goal, ok := args.Body.(*garmin.Goal)
+			if !ok {
+				return nil, fmt.Errorf("invalid goal body type: %T", args.Body)
+			}
+			return client.Goals.Create(ctx, goal)
+		},
+	},
+	{
+		Name:       "UpdateGoal",
+		Service:    "Goals",
+		Cassette:   "none",
+		Path:       "/goal-service/goal/goal/{goalId}",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "goal_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The goal ID to update"},
+		},
+		Body:          goalBodyConfig,
+		CLICommand:    "goals",
+		CLISubcommand: "update",
+		MCPTool:       "update_goal",
+		Short:         "Update a goal",
+		Long:          "Update an existing goal. Use --file to read from a file, --json to pass inline JSON, or pipe JSON to stdin.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			goal, ok := args.Body.(*garmin.Goal)
+			if !ok {
+				return nil, fmt.Errorf("invalid goal body type: %T", args.Body)
+			}
+			return client.Goals.Update(ctx, int64(args.Int("goal_id")), goal)
+		},
+	},
+	{
+		Name:       "DeleteGoal",
+		Service:    "Goals",
+		Cassette:   "none",
+		Path:       "/goal-service/goal/goal/{goalId}",
+		HTTPMethod: "DELETE",
+		Params: []endpoint.Param{
+			{Name: "goal_id", Type: endpoint.ParamTypeInt, Required: true, Description: "The goal ID to delete"},
+		},
+		CLICommand:    "goals",
+		CLISubcommand: "delete",
+		MCPTool:       "delete_goal",
+		Short:         "Delete a goal",
+		Long:          "Permanently delete a goal from your Garmin account",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			err := client.Goals.Delete(ctx, int64(args.Int("goal_id")))
+			if err != nil {
+				return nil, err
+			}
+			return map[string]string{"status": "success"}, nil
+		},
+	},
+}
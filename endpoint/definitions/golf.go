@@ -0,0 +1,85 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// defaultScorecardLimit bounds the number of scorecards returned when no limit is given.
+const defaultScorecardLimit = 20
+
+// golfScorecardResult is a scorecard with its computed aggregates.
+type golfScorecardResult struct {
+	*garmin.GolfScorecardDetail
+	Stats garmin.GolfScorecardStats `json:"stats"`
+}
+
+// firstScorecardID is an ArgProvider supplying the most recent scorecard of the list.
+func firstScorecardID(result any) map[string]any {
+	scorecards, ok := result.([]garmin.GolfScorecardSummary)
+	if !ok || len(scorecards) == 0 {
+		return nil
+	}
+	return map[string]any{"scorecard_id": int(scorecards[0].ID)}
+}
+
+// GolfEndpoints defines all golf-related endpoints.
+var GolfEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "ListGolfScorecards",
+		Service:    "Golf",
+		Cassette:   "golf",
+		Path:       "/gcs-golfcommunity/api/v2/scorecard/summary",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "limit", Type: endpoint.ParamTypeInt, Required: false, Description: "Maximum number of scorecards to return (defaults to 20)"},
+		},
+		CLICommand:    "golf",
+		CLISubcommand: "scorecards",
+		MCPTool:       "list_golf_scorecards",
+		Short:         "List golf scorecards",
+		Long:          "List golf rounds, most recent first, with course, strokes and holes completed",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			limit := args.IntOrDefault("limit", defaultScorecardLimit)
+			if limit <= 0 {
+				limit = defaultScorecardLimit
+			}
+			return collect(client.Golf.Scorecards(ctx), limit)
+		},
+	},
+	{
+		Name:       "GetGolfScorecard",
+		Service:    "Golf",
+		Cassette:   "golf",
+		Path:       "/gcs-golfcommunity/api/v2/scorecard/detail",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "scorecard_id", Type: endpoint.ParamTypeInt, Required: true, Description: "Golf scorecard ID"},
+		},
+		CLICommand:    "golf",
+		CLISubcommand: "scorecard",
+		MCPTool:       "get_golf_scorecard",
+		Short:         "Get a golf scorecard",
+		Long:          "Get a golf round with per-hole strokes, putts, fairway hits and penalties, and computed stats (score to par, average putts, greens in regulation %, fairways hit %)",
+		DependsOn:     "ListGolfScorecards",
+		ArgProvider:   firstScorecardID,
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			detail, err := client.Golf.GetScorecard(ctx, int64(args.Int("scorecard_id")))
+			if err != nil {
+				return nil, err
+			}
+			return &golfScorecardResult{GolfScorecardDetail: detail, Stats: detail.Stats()}, nil
+		},
+	},
+}
@@ -0,0 +1,117 @@
+package definitions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// graphQLQueryText returns the query given inline or read from a file. The file
+// is only read for the CLI: query-file is not exposed to MCP clients.
+func graphQLQueryText(args *endpoint.HandlerArgs) (string, error) {
+	query, file := args.String("query"), args.String("query-file")
+	if query != "" && file != "" {
+		return "", errors.New("only one of --query or --query-file can be given")
+	}
+	if query == "" && file == "" {
+		return "", errors.New("a query is required (--query or --query-file)")
+	}
+	if file == "" {
+		return query, nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("read query file: %w", err)
+	}
+	return string(data), nil
+}
+
+// GraphQLEndpoints defines all GraphQL gateway endpoints.
+var GraphQLEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "GraphQLQuery",
+		Service:    "GraphQL",
+		Cassette:   "none",
+		Path:       "/graphql-gateway/graphql",
+		HTTPMethod: "POST",
+		Params: []endpoint.Param{
+			{Name: "query", Type: endpoint.ParamTypeString, Required: false, Description: "GraphQL query, e.g. query{vo2MaxScalar(startDate:\"2026-01-01\",endDate:\"2026-01-31\")}"},
+			{Name: "query-file", Type: endpoint.ParamTypeString, Required: false, Description: "Path to a file containing the GraphQL query", CLIOnly: true},
+			{Name: "variables", Type: endpoint.ParamTypeString, Required: false, Description: "JSON object of query variables"},
+		},
+		CLICommand: "graphql",
+		MCPTool:    "graphql_query",
+		Short:      "Run a GraphQL query",
+		Long:       "Run a query against the GraphQL gateway used by the Garmin Connect web app and return its data. Most data is exposed as scalar fields returning JSON, such as sleepSummariesScalar, weightScalar or activityStatsScalar.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			query, err := graphQLQueryText(args)
+			if err != nil {
+				return nil, err
+			}
+			var vars map[string]any
+			if s := args.String("variables"); s != "" {
+				if err := json.Unmarshal([]byte(s), &vars); err != nil {
+					return nil, fmt.Errorf("invalid variables: %w", err)
+				}
+			}
+			var data json.RawMessage
+			if err := client.GraphQL.Query(ctx, query, vars, &data); err != nil {
+				return nil, err
+			}
+			return data, nil
+		},
+	},
+	{
+		Name:       "GetHealthDashboard",
+		Service:    "GraphQL",
+		Cassette:   "graphql",
+		Path:       "/graphql-gateway/graphql",
+		HTTPMethod: "POST",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for the dashboard"},
+		},
+		CLICommand:    "dashboard",
+		CLISubcommand: "health",
+		MCPTool:       "get_health_dashboard",
+		Short:         "Get the health dashboard",
+		Long:          "Get HRV, weight, blood pressure, sleep and daily summaries for a date range in a single GraphQL request",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.GraphQL.GetHealthDashboard(ctx, args.Date("start"), args.Date("end"))
+		},
+	},
+	{
+		Name:       "GetTrainingDashboard",
+		Service:    "GraphQL",
+		Cassette:   "graphql",
+		Path:       "/graphql-gateway/graphql",
+		HTTPMethod: "POST",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for the dashboard"},
+		},
+		CLICommand:    "dashboard",
+		CLISubcommand: "training",
+		MCPTool:       "get_training_dashboard",
+		Short:         "Get the training dashboard",
+		Long:          "Get the training status, training readiness, weekly endurance score, VO2 max and scheduled workouts for a date range in a single GraphQL request",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.GraphQL.GetTrainingDashboard(ctx, args.Date("start"), args.Date("end"))
+		},
+	},
+}
@@ -0,0 +1,120 @@
+package definitions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// resolveHydrationAmount returns the ml param, or the volume in milliliters of the
+// named container from the user's settings.
+func resolveHydrationAmount(ctx context.Context, client *garmin.Client, args *endpoint.HandlerArgs) (float64, error) {
+	ml := args.Int("ml")
+	name := args.String("container")
+	switch {
+	case ml != 0 && name != "":
+		return 0, errors.New("specify either ml or container, not both")
+	case ml != 0:
+		return float64(ml), nil
+	case name == "":
+		return 0, errors.New("either ml or container is required")
+	}
+
+	settings, err := client.UserProfile.GetUserSettings(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user settings: %w", err)
+	}
+	container, err := garmin.FindHydrationContainer(settings.UserData.HydrationContainers, name)
+	if err != nil {
+		return 0, err
+	}
+	return container.Milliliters()
+}
+
+// HydrationEndpoints defines all hydration-related endpoints.
+var HydrationEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "GetDailyHydration",
+		Service:    "Hydration",
+		Cassette:   "hydration",
+		Path:       "/usersummary-service/usersummary/hydration/daily/{date}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to get hydration data for (YYYY-MM-DD, defaults to today)"},
+		},
+		CLICommand:    "hydration",
+		CLISubcommand: "daily",
+		MCPTool:       "get_hydration",
+		Short:         "Get hydration data for a date",
+		Long:          "Get hydration data for a date including intake, goal, sweat loss and activity intake in milliliters",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Hydration.GetDaily(ctx, args.Date("date"))
+		},
+	},
+	{
+		Name:       "LogHydration",
+		Service:    "Hydration",
+		Cassette:   "none",
+		Path:       "/usersummary-service/usersummary/hydration/log",
+		HTTPMethod: "POST",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to log hydration for (YYYY-MM-DD, defaults to today)"},
+			{Name: "ml", Type: endpoint.ParamTypeInt, Required: false, Description: "Intake in milliliters (negative to remove intake)"},
+			{Name: "container", Type: endpoint.ParamTypeString, Required: false, Description: "Name of a hydration container from the user's settings to log instead of ml"},
+		},
+		CLICommand:    "hydration",
+		CLISubcommand: "log",
+		MCPTool:       "log_hydration",
+		Short:         "Log hydration intake",
+		Long:          "Log hydration intake for a date, either in milliliters or as a named container from the user's settings",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			ml, err := resolveHydrationAmount(ctx, client, args)
+			if err != nil {
+				return nil, err
+			}
+			// Dates given as YYYY-MM-DD are logged at the current time of day
+			date := args.Date("date")
+			if args.HasParam("date") {
+				now := time.Now()
+				date = time.Date(date.Year(), date.Month(), date.Day(), now.Hour(), now.Minute(), now.Second(), 0, time.Local)
+			}
+			return client.Hydration.Log(ctx, date, ml)
+		},
+	},
+	{
+		Name:       "GetHydrationStats",
+		Service:    "Hydration",
+		Cassette:   "hydration",
+		Path:       "/usersummary-service/stats/hydration/daily/{start}/{end}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for hydration stats"},
+		},
+		CLICommand:    "hydration",
+		CLISubcommand: "stats",
+		MCPTool:       "get_hydration_stats",
+		Short:         "Get hydration stats for a date range",
+		Long:          "Get daily hydration intake and goal for a date range; long ranges are fetched in several requests",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			start := args.Date("start")
+			end := args.Date("end")
+			return client.Hydration.GetStats(ctx, start, end)
+		},
+	},
+}
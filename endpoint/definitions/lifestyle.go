@@ -0,0 +1,132 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// lifestyleRange returns the start and end params, defaulting to the last 7 days.
+func lifestyleRange(args *endpoint.HandlerArgs) (time.Time, time.Time) {
+	end := time.Now()
+	start := end.AddDate(0, 0, -6)
+	if args.HasParam("end") {
+		end = args.Date("end")
+	}
+	if args.HasParam("start") {
+		start = args.Date("start")
+	}
+	return start, end
+}
+
+// LifestyleEndpoints defines all lifestyle logging-related endpoints.
+var LifestyleEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "GetLifestyleDailyLog",
+		Service:    "Lifestyle",
+		Cassette:   "lifestyle",
+		Path:       "/lifestylelogging-service/dailyLog/{date}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to get the lifestyle log for (YYYY-MM-DD, defaults to today)"},
+		},
+		CLICommand:    "lifestyle",
+		CLISubcommand: "daily",
+		MCPTool:       "get_lifestyle_log",
+		Short:         "Get the lifestyle log for a date",
+		Long:          "Get the behaviors tracked in the lifestyle log for a date (alcohol, caffeine, late meals and custom behaviors) with their logged status",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Lifestyle.GetDaily(ctx, args.Date("date"))
+		},
+	},
+	{
+		Name:       "LogLifestyleBehavior",
+		Service:    "Lifestyle",
+		Cassette:   "none",
+		Path:       "/lifestylelogging-service/dailyLog",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "behavior", Type: endpoint.ParamTypeString, Required: true, Description: "Name of the behavior as shown in the lifestyle log (e.g. Alcohol, Caffeine, Late Meal)"},
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to log the behavior for (YYYY-MM-DD, defaults to today)"},
+			{Name: "no", Type: endpoint.ParamTypeBool, Required: false, Description: "Log that the behavior did not happen"},
+		},
+		CLICommand:    "lifestyle",
+		CLISubcommand: "log",
+		MCPTool:       "log_lifestyle_behavior",
+		Short:         "Log a lifestyle behavior",
+		Long:          "Log whether a tracked behavior happened on a date and return the updated lifestyle log",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			status := garmin.LifestyleYes
+			if args.Bool("no") {
+				status = garmin.LifestyleNo
+			}
+			date := args.Date("date")
+			if err := client.Lifestyle.LogBehavior(ctx, date, args.String("behavior"), status); err != nil {
+				return nil, err
+			}
+			return client.Lifestyle.GetDaily(ctx, date)
+		},
+	},
+	{
+		Name:       "GetLifestyleRange",
+		Service:    "Lifestyle",
+		Cassette:   "none",
+		Path:       "/lifestylelogging-service/dailyLog/{date}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for the lifestyle log (default: last 7 days)"},
+		},
+		CLICommand:    "lifestyle",
+		CLISubcommand: "range",
+		MCPTool:       "get_lifestyle_range",
+		Short:         "Get the lifestyle log for a date range with sleep and HRV",
+		Long:          "Get the lifestyle log of each day of a date range, paired with the sleep and overnight HRV of the following night. Makes one request per day.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			start, end := lifestyleRange(args)
+			return client.Lifestyle.GetRange(ctx, start, end)
+		},
+	},
+	{
+		Name:       "GetLifestyleImpact",
+		Service:    "Lifestyle",
+		Cassette:   "none",
+		Path:       "/lifestylelogging-service/dailyLog/{date}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "behavior", Type: endpoint.ParamTypeString, Required: true, Description: "Name of the behavior as shown in the lifestyle log (e.g. Alcohol, Caffeine, Late Meal)"},
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range to compare (default: last 7 days)"},
+		},
+		CLICommand:    "lifestyle",
+		CLISubcommand: "impact",
+		MCPTool:       "get_lifestyle_impact",
+		Short:         "Compare sleep and HRV after days with and without a behavior",
+		Long:          "Compare average sleep duration and overnight HRV of the nights following days on which a behavior was logged as happened or not happened. Makes one request per day.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			start, end := lifestyleRange(args)
+			lifestyle, err := client.Lifestyle.GetRange(ctx, start, end)
+			if err != nil {
+				return nil, err
+			}
+			return lifestyle.Impact(args.String("behavior")), nil
+		},
+	},
+}
@@ -76,6 +76,34 @@ var MetricsEndpoints = []endpoint.Endpoint{
 			return client.Metrics.GetHillScore(ctx, args.Date("date"))
 		},
 	},
+	{
+		Name:       "GetHillScoreStats",
+		Service:    "Metrics",
+		Cassette:   "metrics",
+		Path:       "/metrics-service/metrics/hillscore/stats",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for hill score stats"},
+			{Name: "aggregation", Type: endpoint.ParamTypeString, Required: false, Description: "Aggregation period: daily, weekly, monthly (default: weekly)"},
+		},
+		CLICommand:    "metrics",
+		CLISubcommand: "hill-stats",
+		MCPTool:       "get_hill_score_stats",
+		Short:         "Get hill score stats",
+		Long:          "Get hill scores over a date range with the average score per period and the best score",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			aggregation, err := parseAggregation(args.String("aggregation"), garmin.AggregationWeekly,
+				garmin.AggregationDaily, garmin.AggregationWeekly, garmin.AggregationMonthly)
+			if err != nil {
+				return nil, err
+			}
+			return client.Metrics.GetHillScoreStats(ctx, args.Date("start"), args.Date("end"), aggregation)
+		},
+	},
 	{
 		Name:       "GetMaxMetLatest",
 		Service:    "Metrics",
@@ -247,4 +275,41 @@ var MetricsEndpoints = []endpoint.Endpoint{
 			return client.Metrics.GetRacePredictionsLatest(ctx, displayName)
 		},
 	},
+	{
+		Name:       "GetRacePredictionsRange",
+		Service:    "Metrics",
+		Cassette:   "metrics",
+		Path:       "/metrics-service/metrics/racepredictions/{granularity}/{displayName}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for race predictions"},
+			{Name: "granularity", Type: endpoint.ParamTypeString, Required: false, Description: "One prediction per day or per month: daily, monthly (default: daily)"},
+			{Name: "display_name", Type: endpoint.ParamTypeString, Required: false, Description: "User display name (defaults to current user)"},
+		},
+		CLICommand:    "metrics",
+		CLISubcommand: "race-predictions-range",
+		MCPTool:       "get_race_predictions_range",
+		Short:         "Get race predictions history",
+		Long:          "Get the history of predicted race times for 5K, 10K, half marathon, and marathon over a date range, to see how fitness evolved during a training block",
+		DependsOn:     "GetSocialProfile",
+		ArgProvider: func(result any) map[string]any {
+			profile, ok := result.(*garmin.SocialProfile)
+			if !ok || profile == nil {
+				return nil
+			}
+			return map[string]any{"display_name": profile.DisplayName}
+		},
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			granularity, err := parseAggregation(args.String("granularity"), garmin.AggregationDaily,
+				garmin.AggregationDaily, garmin.AggregationMonthly)
+			if err != nil {
+				return nil, err
+			}
+			return client.Metrics.GetRacePredictionsRange(ctx, args.String("display_name"), args.Date("start"), args.Date("end"), granularity)
+		},
+	},
 }
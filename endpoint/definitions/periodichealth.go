@@ -0,0 +1,76 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// PeriodicHealthEndpoints defines all women's health-related endpoints.
+var PeriodicHealthEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "GetMenstrualCycleDayView",
+		Service:    "PeriodicHealth",
+		Cassette:   "periodichealth",
+		Path:       "/periodichealth-service/menstrualcycle/dayview/{date}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to get cycle data for (YYYY-MM-DD, defaults to today)"},
+		},
+		CLICommand:    "womenshealth",
+		CLISubcommand: "day",
+		MCPTool:       "get_menstrual_cycle_day",
+		Short:         "Get menstrual cycle data for a date",
+		Long:          "Get menstrual cycle data for a date including the day in cycle, the cycle phase (1=menstrual, 2=follicular, 3=ovulation, 4=luteal) and the daily log",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.PeriodicHealth.GetDayView(ctx, args.Date("date"))
+		},
+	},
+	{
+		Name:       "GetMenstrualCalendar",
+		Service:    "PeriodicHealth",
+		Cassette:   "periodichealth",
+		Path:       "/periodichealth-service/menstrualcycle/calendar/{start}/{end}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for the menstrual calendar"},
+		},
+		CLICommand:    "womenshealth",
+		CLISubcommand: "calendar",
+		MCPTool:       "get_menstrual_calendar",
+		Short:         "Get the menstrual calendar for a date range",
+		Long:          "Get the menstrual cycles overlapping a date range, including predicted cycles, with period length and fertile window",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.PeriodicHealth.GetCalendar(ctx, args.Date("start"), args.Date("end"))
+		},
+	},
+	{
+		Name:          "GetPregnancySnapshot",
+		Service:       "PeriodicHealth",
+		Cassette:      "periodichealth",
+		Path:          "/periodichealth-service/menstrualcycle/pregnancysnapshot",
+		HTTPMethod:    "GET",
+		CLICommand:    "womenshealth",
+		CLISubcommand: "pregnancy",
+		MCPTool:       "get_pregnancy_snapshot",
+		Short:         "Get the current pregnancy",
+		Long:          "Get the pregnancy being tracked including week of pregnancy, trimester and due date",
+		Handler: func(ctx context.Context, c any, _ *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.PeriodicHealth.GetPregnancySnapshot(ctx)
+		},
+	},
+}
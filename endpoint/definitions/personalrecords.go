@@ -0,0 +1,46 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// PersonalRecordEndpoints defines all personal record-related endpoints.
+var PersonalRecordEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "GetPersonalRecords",
+		Service:    "PersonalRecords",
+		Cassette:   "personalrecords",
+		Path:       "/personalrecord-service/personalrecord/prs/{displayName}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "display_name", Type: endpoint.ParamTypeString, Required: false, Description: "User display name (defaults to current user)"},
+		},
+		CLICommand:    "records",
+		CLISubcommand: "list",
+		MCPTool:       "get_personal_records",
+		Short:         "Get personal records",
+		Long: `Get all personal records with the activity each record was set in.
+Record types (typeId): 1=1K, 2=1 mile, 3=5K, 4=10K, 5=half marathon, 6=marathon (value in seconds),
+7=longest run, 8=longest ride, 9=ride total ascent (value in meters), 10=max avg power over 20 min (watts),
+11=40K ride (seconds), 12/13/14=most steps in a day/week/month, 15=longest goal streak (days)`,
+		DependsOn: "GetSocialProfile",
+		ArgProvider: func(result any) map[string]any {
+			profile, ok := result.(*garmin.SocialProfile)
+			if !ok || profile == nil {
+				return nil
+			}
+			return map[string]any{"display_name": profile.DisplayName}
+		},
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.PersonalRecords.GetAll(ctx, args.String("display_name"))
+		},
+	},
+}
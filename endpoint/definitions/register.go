@@ -53,4 +53,46 @@ func RegisterAll(r *endpoint.Registry) {
 	for i := range CourseEndpoints {
 		r.Register(CourseEndpoints[i])
 	}
+	for i := range UploadEndpoints {
+		r.Register(UploadEndpoints[i])
+	}
+	for i := range GearEndpoints {
+		r.Register(GearEndpoints[i])
+	}
+	for i := range GoalEndpoints {
+		r.Register(GoalEndpoints[i])
+	}
+	for i := range BadgeEndpoints {
+		r.Register(BadgeEndpoints[i])
+	}
+	for i := range HydrationEndpoints {
+		r.Register(HydrationEndpoints[i])
+	}
+	for i := range BloodPressureEndpoints {
+		r.Register(BloodPressureEndpoints[i])
+	}
+	for i := range PersonalRecordEndpoints {
+		r.Register(PersonalRecordEndpoints[i])
+	}
+	for i := range StepsEndpoints {
+		r.Register(StepsEndpoints[i])
+	}
+	for i := range DownloadEndpoints {
+		r.Register(DownloadEndpoints[i])
+	}
+	for i := range TrainingPlanEndpoints {
+		r.Register(TrainingPlanEndpoints[i])
+	}
+	for i := range PeriodicHealthEndpoints {
+		r.Register(PeriodicHealthEndpoints[i])
+	}
+	for i := range GolfEndpoints {
+		r.Register(GolfEndpoints[i])
+	}
+	for i := range LifestyleEndpoints {
+		r.Register(LifestyleEndpoints[i])
+	}
+	for i := range GraphQLEndpoints {
+		r.Register(GraphQLEndpoints[i])
+	}
 }
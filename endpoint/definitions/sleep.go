@@ -30,7 +30,7 @@ var SleepEndpoints = []endpoint.Endpoint{
 		CLICommand: "sleep",
 		MCPTool:    "get_sleep",
 		Short:      "Get sleep data for a date",
-		Long:       "Get sleep data including duration, stages (deep, light, REM), and sleep score",
+		Long:       "Get sleep data including duration, the sleep score and its breakdown, the timeline of sleep stages (deep, light, REM, awake), and overnight heart rate, SpO2 and respiration",
 
 		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
 			client, ok := c.(*garmin.Client)
@@ -40,4 +40,33 @@ var SleepEndpoints = []endpoint.Endpoint{
 			return client.Sleep.GetDaily(ctx, args.Date("date"))
 		},
 	},
+	{
+		Name:       "GetSleepScoreStats",
+		Service:    "Sleep",
+		Cassette:   "sleep_scores",
+		Path:       "/wellness-service/stats/daily/sleep/score/{start}/{end}",
+		HTTPMethod: "GET",
+
+		Params: []endpoint.Param{
+			{
+				Name:        "range",
+				Type:        endpoint.ParamTypeDateRange,
+				Required:    false,
+				Description: "Date range for sleep scores",
+			},
+		},
+
+		CLICommand: "sleepscores",
+		MCPTool:    "get_sleep_score_stats",
+		Short:      "Get sleep scores for a date range",
+		Long:       "Get the sleep score, its quality and the sleep stage durations for each night of a date range, with the average score",
+
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Sleep.GetScoreStats(ctx, args.Date("start"), args.Date("end"))
+		},
+	},
 }
@@ -0,0 +1,98 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// defaultStepsWeeks is the number of weeks returned by the weekly steps endpoint when not set.
+const defaultStepsWeeks = 4
+
+// StepsEndpoints defines all steps-related endpoints.
+var StepsEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "GetDailySteps",
+		Service:    "Steps",
+		Cassette:   "steps",
+		Path:       "/usersummary-service/stats/steps/daily/{start}/{end}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for daily steps"},
+		},
+		CLICommand:    "steps",
+		CLISubcommand: "daily",
+		MCPTool:       "get_daily_steps",
+		Short:         "Get daily steps for a date range",
+		Long:          "Get total steps, distance and step goal for each day of a date range; ranges longer than 28 days are fetched in several requests",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			start := args.Date("start")
+			end := args.Date("end")
+			return client.Steps.GetDaily(ctx, start, end)
+		},
+	},
+	{
+		Name:       "GetWeeklySteps",
+		Service:    "Steps",
+		Cassette:   "steps",
+		Path:       "/usersummary-service/stats/steps/weekly/{end}/{weeks}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "End date of the last week (YYYY-MM-DD, defaults to today)"},
+			{Name: "weeks", Type: endpoint.ParamTypeInt, Required: false, Description: "Number of weeks (defaults to 4)"},
+		},
+		CLICommand:    "steps",
+		CLISubcommand: "weekly",
+		MCPTool:       "get_weekly_steps",
+		Short:         "Get weekly steps",
+		Long:          "Get total and average steps and distance per week for the given number of weeks",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			weeks := args.IntOrDefault("weeks", defaultStepsWeeks)
+			if weeks <= 0 {
+				weeks = defaultStepsWeeks
+			}
+			return client.Steps.GetWeekly(ctx, args.Date("date"), weeks)
+		},
+	},
+	{
+		Name:       "GetIntradaySteps",
+		Service:    "Steps",
+		Cassette:   "steps",
+		Path:       "/wellness-service/wellness/dailySummaryChart/{displayName}?date={date}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to get steps for (YYYY-MM-DD, defaults to today)"},
+			{Name: "display_name", Type: endpoint.ParamTypeString, Required: false, Description: "User display name (defaults to current user)"},
+		},
+		CLICommand:    "steps",
+		CLISubcommand: "intraday",
+		MCPTool:       "get_intraday_steps",
+		Short:         "Get steps in 15-minute intervals",
+		Long:          "Get the steps of a day in 15-minute intervals with the primary activity level of each interval",
+		DependsOn:     "GetSocialProfile",
+		ArgProvider: func(result any) map[string]any {
+			profile, ok := result.(*garmin.SocialProfile)
+			if !ok || profile == nil {
+				return nil
+			}
+			return map[string]any{"display_name": profile.DisplayName}
+		},
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Steps.GetIntraday(ctx, args.String("display_name"), args.Date("date"))
+		},
+	},
+}
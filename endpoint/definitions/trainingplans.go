@@ -0,0 +1,130 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// firstTrainingPlanID returns an ArgProvider supplying the first plan of the list
+// that is (or is not) adaptive.
+func firstTrainingPlanID(adaptive bool) func(result any) map[string]any {
+	return func(result any) map[string]any {
+		plans, ok := result.(*garmin.TrainingPlanList)
+		if !ok {
+			return nil
+		}
+		for i := range plans.TrainingPlanList {
+			if plans.TrainingPlanList[i].IsAdaptive() == adaptive {
+				return map[string]any{"plan_id": int(plans.TrainingPlanList[i].TrainingPlanID)}
+			}
+		}
+		return nil
+	}
+}
+
+// TrainingPlanEndpoints defines all training plan-related endpoints.
+var TrainingPlanEndpoints = []endpoint.Endpoint{
+	{
+		Name:          "ListTrainingPlans",
+		Service:       "TrainingPlans",
+		Cassette:      "trainingplans",
+		Path:          "/trainingplan-service/trainingplan/plans",
+		HTTPMethod:    "GET",
+		CLICommand:    "trainingplans",
+		CLISubcommand: "list",
+		MCPTool:       "list_training_plans",
+		Short:         "List training plans",
+		Long:          "List the training plans of the user, both phased and adaptive (Garmin Coach) plans",
+		Handler: func(ctx context.Context, c any, _ *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.TrainingPlans.List(ctx)
+		},
+	},
+	{
+		Name:       "GetPhasedTrainingPlan",
+		Service:    "TrainingPlans",
+		Cassette:   "trainingplans",
+		Path:       "/trainingplan-service/trainingplan/phased/{planId}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "plan_id", Type: endpoint.ParamTypeInt, Required: true, Description: "Training plan ID"},
+		},
+		CLICommand:    "trainingplans",
+		CLISubcommand: "phased",
+		MCPTool:       "get_phased_training_plan",
+		Short:         "Get a phased training plan",
+		Long:          "Get a phased training plan with its phases and daily tasks",
+		DependsOn:     "ListTrainingPlans",
+		ArgProvider:   firstTrainingPlanID(false),
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.TrainingPlans.GetPhased(ctx, int64(args.Int("plan_id")))
+		},
+	},
+	{
+		Name:       "GetAdaptiveTrainingPlan",
+		Service:    "TrainingPlans",
+		Cassette:   "trainingplans",
+		Path:       "/trainingplan-service/trainingplan/fbt-adaptive/{planId}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "plan_id", Type: endpoint.ParamTypeInt, Required: true, Description: "Training plan ID"},
+		},
+		CLICommand:    "trainingplans",
+		CLISubcommand: "adaptive",
+		MCPTool:       "get_adaptive_training_plan",
+		Short:         "Get an adaptive training plan",
+		Long:          "Get an adaptive (Garmin Coach) training plan with its phases and daily tasks",
+		DependsOn:     "ListTrainingPlans",
+		ArgProvider:   firstTrainingPlanID(true),
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.TrainingPlans.GetAdaptive(ctx, int64(args.Int("plan_id")))
+		},
+	},
+	{
+		Name:       "GetTrainingPlanSchedule",
+		Service:    "TrainingPlans",
+		Cassette:   "none",
+		Path:       "/trainingplan-service/trainingplan/{phased|fbt-adaptive}/{planId}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "plan_id", Type: endpoint.ParamTypeInt, Required: true, Description: "Training plan ID"},
+			{Name: "from", Type: endpoint.ParamTypeString, Required: false, Description: "First date of the schedule (YYYY-MM-DD, defaults to today)"},
+			{Name: "workouts", Type: endpoint.ParamTypeBool, Required: false, Description: "Include the full workout of each task (one request per workout)"},
+		},
+		CLICommand:    "trainingplans",
+		CLISubcommand: "schedule",
+		MCPTool:       "get_training_plan_schedule",
+		Short:         "Get the upcoming schedule of a training plan",
+		Long:          "Get the upcoming tasks of a training plan, each linked to its calendar entry and optionally to its full workout",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			opts := &garmin.TrainingPlanScheduleOptions{IncludeWorkouts: args.Bool("workouts")}
+			if s := args.String("from"); s != "" {
+				from, err := time.Parse("2006-01-02", s)
+				if err != nil {
+					return nil, fmt.Errorf("invalid from date: %w", err)
+				}
+				opts.From = from
+			}
+			return client.TrainingPlans.GetSchedule(ctx, int64(args.Int("plan_id")), opts)
+		},
+	},
+}
@@ -0,0 +1,50 @@
+package definitions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	garmin "github.com/llehouerou/go-garmin"
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+// UploadEndpoints defines all upload-related API endpoints.
+var UploadEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "UploadActivity",
+		Service:    "Upload",
+		Cassette:   "none",
+		Path:       "/upload-service/upload",
+		HTTPMethod: "POST",
+
+		Params: []endpoint.Param{
+			{
+				Name:        "file",
+				Type:        endpoint.ParamTypeString,
+				Required:    true,
+				Description: "Path to the FIT, TCX or GPX activity file to upload",
+			},
+		},
+
+		CLICommand: "upload",
+		MCPTool:    "upload_activity",
+		Short:      "Upload an activity file",
+		Long:       "Upload a FIT, TCX or GPX activity file to Garmin Connect and wait for processing. Returns the IDs of the created activities; fails with a duplicate error if the activity was already uploaded.",
+
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			filePath := args.String("file")
+			f, err := os.Open(filePath)
+			if err != nil {
+				return nil, fmt.Errorf("open file: %w", err)
+			}
+			defer f.Close()
+			return client.Upload.UploadActivity(ctx, filepath.Base(filePath), f)
+		},
+	},
+}
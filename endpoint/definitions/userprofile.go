@@ -2,12 +2,57 @@ package definitions
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/llehouerou/go-garmin"
 	"github.com/llehouerou/go-garmin/endpoint"
 )
 
+// parseUserSettingsArgs builds the user settings update from the optional parameters.
+func parseUserSettingsArgs(args *endpoint.HandlerArgs) (*garmin.UserSettingsUpdate, error) {
+	update := &garmin.UserSettingsUpdate{}
+	choices := []struct {
+		param string
+		field **string
+	}{
+		{"measurement_system", &update.MeasurementSystem},
+		{"time_format", &update.TimeFormat},
+		{"heart_rate_format", &update.HeartRateFormat},
+		{"power_format", &update.PowerFormat},
+		{"hydration_unit", &update.HydrationMeasurementUnit},
+	}
+	for _, c := range choices {
+		if v := args.String(c.param); v != "" {
+			*c.field = &v
+		}
+	}
+	clocks := []struct {
+		param string
+		field **int
+	}{
+		{"sleep_time", &update.SleepTime},
+		{"wake_time", &update.WakeTime},
+	}
+	for _, c := range clocks {
+		v := args.String(c.param)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse("15:04", v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %q (expected HH:MM)", c.param, v)
+		}
+		seconds := t.Hour()*3600 + t.Minute()*60
+		*c.field = &seconds
+	}
+	if update.IsEmpty() {
+		return nil, errors.New("at least one setting to update is required")
+	}
+	return update, nil
+}
+
 // UserProfileEndpoints defines all user profile-related endpoints.
 var UserProfileEndpoints = []endpoint.Endpoint{
 	{
@@ -67,4 +112,39 @@ var UserProfileEndpoints = []endpoint.Endpoint{
 			return client.UserProfile.GetProfileSettings(ctx)
 		},
 	},
+	{
+		Name:       "UpdateUserSettings",
+		Service:    "UserProfile",
+		Cassette:   "none",
+		Path:       "/userprofile-service/userprofile/user-settings",
+		HTTPMethod: "PUT",
+		Params: []endpoint.Param{
+			{Name: "measurement_system", Type: endpoint.ParamTypeString, Required: false, Description: "Units: metric, statute_us or statute_uk"},
+			{Name: "time_format", Type: endpoint.ParamTypeString, Required: false, Description: "Time format: time_twelve_hr or time_twenty_four_hr"},
+			{Name: "heart_rate_format", Type: endpoint.ParamTypeString, Required: false, Description: "Heart rate display format key (e.g. bpm)"},
+			{Name: "power_format", Type: endpoint.ParamTypeString, Required: false, Description: "Power display format key (e.g. watt)"},
+			{Name: "hydration_unit", Type: endpoint.ParamTypeString, Required: false, Description: "Hydration unit: ml, oz or cup"},
+			{Name: "sleep_time", Type: endpoint.ParamTypeString, Required: false, Description: "Usual bedtime (HH:MM)"},
+			{Name: "wake_time", Type: endpoint.ParamTypeString, Required: false, Description: "Usual wake time (HH:MM)"},
+		},
+		CLICommand:    "profile",
+		CLISubcommand: "update-settings",
+		MCPTool:       "update_user_settings",
+		Short:         "Update user settings",
+		Long:          "Change the user's units, display formats and usual sleep window. Only the given settings are changed.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			update, err := parseUserSettingsArgs(args)
+			if err != nil {
+				return nil, err
+			}
+			if err := client.UserProfile.UpdateUserSettings(ctx, update); err != nil {
+				return nil, err
+			}
+			return client.UserProfile.GetUserSettings(ctx)
+		},
+	},
 }
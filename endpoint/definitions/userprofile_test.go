@@ -0,0 +1,50 @@
+// endpoint/definitions/userprofile_test.go
+package definitions
+
+import (
+	"testing"
+
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+func TestParseUserSettingsArgs(t *testing.T) {
+	args := &endpoint.HandlerArgs{Params: map[string]any{
+		"measurement_system": "metric",
+		"sleep_time":         "22:30",
+		"wake_time":          "06:45",
+	}}
+
+	update, err := parseUserSettingsArgs(args)
+	if err != nil {
+		t.Fatalf("parseUserSettingsArgs failed: %v", err)
+	}
+	if update.MeasurementSystem == nil || *update.MeasurementSystem != "metric" {
+		t.Errorf("MeasurementSystem = %v, want metric", update.MeasurementSystem)
+	}
+	if update.TimeFormat != nil {
+		t.Errorf("TimeFormat = %q, want nil", *update.TimeFormat)
+	}
+	if update.SleepTime == nil || *update.SleepTime != 81000 {
+		t.Errorf("SleepTime = %v, want 81000", update.SleepTime)
+	}
+	if update.WakeTime == nil || *update.WakeTime != 24300 {
+		t.Errorf("WakeTime = %v, want 24300", update.WakeTime)
+	}
+}
+
+func TestParseUserSettingsArgs_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]any
+	}{
+		{"no settings", map[string]any{}},
+		{"invalid sleep time", map[string]any{"sleep_time": "10pm"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseUserSettingsArgs(&endpoint.HandlerArgs{Params: tt.params}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -2,12 +2,84 @@ package definitions
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/llehouerou/go-garmin"
 	"github.com/llehouerou/go-garmin/endpoint"
 )
 
+// weighInTimeFormats are the accepted layouts for weigh-in timestamps.
+var weighInTimeFormats = []string{"2006-01-02 15:04", "2006-01-02 15:04:05"}
+
+// parseWeighInArgs builds a weigh-in from the --kg or --lbs flag and the optional
+// body composition flags. Masses are given in the same unit as the weight.
+func parseWeighInArgs(args *endpoint.HandlerArgs) (*garmin.WeighIn, error) {
+	kg, lbs := args.String("kg"), args.String("lbs")
+	if (kg == "") == (lbs == "") {
+		return nil, errors.New("exactly one of --kg or --lbs is required")
+	}
+	toKg := func(v float64) float64 { return v }
+	value := kg
+	if lbs != "" {
+		toKg = garmin.LbsToKg
+		value = lbs
+	}
+
+	parse := func(name, s string) (*float64, error) {
+		if s == "" {
+			return nil, nil
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %q", name, s)
+		}
+		return &v, nil
+	}
+
+	weight, err := parse("weight", value)
+	if err != nil {
+		return nil, err
+	}
+	weighIn := &garmin.WeighIn{WeightKg: toKg(*weight)}
+
+	if s := args.String("timestamp"); s != "" {
+		for _, layout := range weighInTimeFormats {
+			if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+				weighIn.Timestamp = t
+				break
+			}
+		}
+		if weighIn.Timestamp.IsZero() {
+			return nil, fmt.Errorf("invalid timestamp: %q (expected YYYY-MM-DD HH:MM[:SS])", s)
+		}
+	}
+
+	if weighIn.BodyFat, err = parse("body fat", args.String("body_fat")); err != nil {
+		return nil, err
+	}
+	if weighIn.BodyWater, err = parse("body water", args.String("body_water")); err != nil {
+		return nil, err
+	}
+	if weighIn.BMI, err = parse("BMI", args.String("bmi")); err != nil {
+		return nil, err
+	}
+	if weighIn.MuscleMass, err = parse("muscle mass", args.String("muscle_mass")); err != nil {
+		return nil, err
+	}
+	if weighIn.BoneMass, err = parse("bone mass", args.String("bone_mass")); err != nil {
+		return nil, err
+	}
+	for _, mass := range []*float64{weighIn.MuscleMass, weighIn.BoneMass} {
+		if mass != nil {
+			*mass = toKg(*mass)
+		}
+	}
+	return weighIn, nil
+}
+
 // WeightEndpoints defines all weight-related endpoints.
 var WeightEndpoints = []endpoint.Endpoint{
 	{
@@ -55,4 +127,94 @@ var WeightEndpoints = []endpoint.Endpoint{
 			return client.Weight.GetRange(ctx, start, end)
 		},
 	},
+	{
+		Name:       "GetBodyComposition",
+		Service:    "Weight",
+		Cassette:   "weight",
+		Path:       "/weight-service/weight/daterangesnapshot",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for body composition data"},
+		},
+		CLICommand:    "weight",
+		CLISubcommand: "composition",
+		MCPTool:       "get_body_composition",
+		Short:         "Get body composition for a date range",
+		Long:          "Get a snapshot of body composition measurements (body fat, body water, muscle and bone mass, BMI) for a date range",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Weight.GetBodyComposition(ctx, args.Date("start"), args.Date("end"))
+		},
+	},
+	{
+		Name:       "AddWeighIn",
+		Service:    "Weight",
+		Cassette:   "none",
+		Path:       "/weight-service/user-weight",
+		HTTPMethod: "POST",
+		Params: []endpoint.Param{
+			{Name: "kg", Type: endpoint.ParamTypeString, Required: false, Description: "Weight in kilograms (masses are then also in kilograms)"},
+			{Name: "lbs", Type: endpoint.ParamTypeString, Required: false, Description: "Weight in pounds (masses are then also in pounds)"},
+			{Name: "timestamp", Type: endpoint.ParamTypeString, Required: false, Description: "Local time of the weigh-in (YYYY-MM-DD HH:MM, defaults to now)"},
+			{Name: "body_fat", Type: endpoint.ParamTypeString, Required: false, Description: "Body fat percentage"},
+			{Name: "body_water", Type: endpoint.ParamTypeString, Required: false, Description: "Body water percentage"},
+			{Name: "muscle_mass", Type: endpoint.ParamTypeString, Required: false, Description: "Muscle mass, in the unit of the weight"},
+			{Name: "bone_mass", Type: endpoint.ParamTypeString, Required: false, Description: "Bone mass, in the unit of the weight"},
+			{Name: "bmi", Type: endpoint.ParamTypeString, Required: false, Description: "Body mass index"},
+		},
+		CLICommand:    "weight",
+		CLISubcommand: "add",
+		MCPTool:       "add_weigh_in",
+		Short:         "Add a weigh-in",
+		Long:          "Add a manual weigh-in with optional body composition, e.g. from a third-party smart scale. Give the weight with either --kg or --lbs.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			weighIn, err := parseWeighInArgs(args)
+			if err != nil {
+				return nil, err
+			}
+			if weighIn.Timestamp.IsZero() {
+				weighIn.Timestamp = time.Now()
+			}
+			if err := client.Weight.AddWeighIn(ctx, weighIn); err != nil {
+				return nil, err
+			}
+			return client.Weight.GetDaily(ctx, weighIn.Timestamp)
+		},
+	},
+	{
+		Name:       "DeleteWeighIn",
+		Service:    "Weight",
+		Cassette:   "none",
+		Path:       "/weight-service/weight/{date}/byversion/{weightPK}",
+		HTTPMethod: "DELETE",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: true, Description: "Date of the weigh-in (YYYY-MM-DD)"},
+			{Name: "weight_pk", Type: endpoint.ParamTypeInt, Required: true, Description: "ID of the weigh-in (samplePk in the daily weight data)"},
+		},
+		CLICommand:    "weight",
+		CLISubcommand: "delete",
+		MCPTool:       "delete_weigh_in",
+		Short:         "Delete a weigh-in",
+		Long:          "Delete a weigh-in identified by its date and samplePk. The CLI asks for confirmation unless --yes is given.",
+		Confirm: func(args *endpoint.HandlerArgs) string {
+			return fmt.Sprintf("Delete weigh-in %d of %s?", args.Int("weight_pk"), args.Date("date").Format("2006-01-02"))
+		},
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			if err := client.Weight.Delete(ctx, args.Date("date"), int64(args.Int("weight_pk"))); err != nil {
+				return nil, err
+			}
+			return map[string]string{"status": "success"}, nil
+		},
+	},
 }
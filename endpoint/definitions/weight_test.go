@@ -0,0 +1,79 @@
+// endpoint/definitions/weight_test.go
+package definitions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/llehouerou/go-garmin/endpoint"
+)
+
+func TestParseWeighInArgs_Kg(t *testing.T) {
+	args := &endpoint.HandlerArgs{Params: map[string]any{
+		"kg":          "72.5",
+		"body_fat":    "18.2",
+		"muscle_mass": "33.1",
+		"timestamp":   "2026-01-27 07:30",
+	}}
+
+	weighIn, err := parseWeighInArgs(args)
+	if err != nil {
+		t.Fatalf("parseWeighInArgs failed: %v", err)
+	}
+	if weighIn.WeightKg != 72.5 {
+		t.Errorf("WeightKg = %v, want 72.5", weighIn.WeightKg)
+	}
+	if weighIn.BodyFat == nil || *weighIn.BodyFat != 18.2 {
+		t.Errorf("BodyFat = %v, want 18.2", weighIn.BodyFat)
+	}
+	if weighIn.MuscleMass == nil || *weighIn.MuscleMass != 33.1 {
+		t.Errorf("MuscleMass = %v, want 33.1", weighIn.MuscleMass)
+	}
+	if weighIn.BoneMass != nil {
+		t.Errorf("BoneMass = %v, want nil", *weighIn.BoneMass)
+	}
+	if got := weighIn.Timestamp.Format("2006-01-02 15:04"); got != "2026-01-27 07:30" {
+		t.Errorf("Timestamp = %s, want 2026-01-27 07:30", got)
+	}
+}
+
+func TestParseWeighInArgs_LbsConvertsMasses(t *testing.T) {
+	args := &endpoint.HandlerArgs{Params: map[string]any{
+		"lbs":       "160",
+		"bone_mass": "7",
+		"body_fat":  "20",
+	}}
+
+	weighIn, err := parseWeighInArgs(args)
+	if err != nil {
+		t.Fatalf("parseWeighInArgs failed: %v", err)
+	}
+	if math.Abs(weighIn.WeightKg-72.5748) > 0.001 {
+		t.Errorf("WeightKg = %v, want ~72.575", weighIn.WeightKg)
+	}
+	if weighIn.BoneMass == nil || math.Abs(*weighIn.BoneMass-3.1751) > 0.001 {
+		t.Errorf("BoneMass = %v, want ~3.175 kg", weighIn.BoneMass)
+	}
+	// Percentages are not converted
+	if weighIn.BodyFat == nil || *weighIn.BodyFat != 20 {
+		t.Errorf("BodyFat = %v, want 20", weighIn.BodyFat)
+	}
+}
+
+func TestParseWeighInArgs_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]any
+	}{
+		{"no weight", map[string]any{}},
+		{"both units", map[string]any{"kg": "70", "lbs": "154"}},
+		{"invalid weight", map[string]any{"kg": "heavy"}},
+		{"invalid body fat", map[string]any{"kg": "70", "body_fat": "x"}},
+		{"invalid timestamp", map[string]any{"kg": "70", "timestamp": "yesterday"}},
+	}
+	for _, tt := range tests {
+		if _, err := parseWeighInArgs(&endpoint.HandlerArgs{Params: tt.params}); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
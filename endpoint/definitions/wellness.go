@@ -8,8 +8,42 @@ import (
 	"github.com/llehouerou/go-garmin/endpoint"
 )
 
+// defaultStatsWeeks is the number of weeks returned by weekly stats endpoints when not set.
+const defaultStatsWeeks = 4
+
+// statsWeeks returns the weeks param, defaulting to defaultStatsWeeks.
+func statsWeeks(args *endpoint.HandlerArgs) int {
+	weeks := args.IntOrDefault("weeks", defaultStatsWeeks)
+	if weeks <= 0 {
+		return defaultStatsWeeks
+	}
+	return weeks
+}
+
 // WellnessEndpoints defines all wellness-related endpoints.
 var WellnessEndpoints = []endpoint.Endpoint{
+	{
+		Name:       "GetDailySummary",
+		Service:    "Wellness",
+		Cassette:   "dailysummary",
+		Path:       "/usersummary-service/usersummary/daily/{displayName}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to get the summary for (YYYY-MM-DD, defaults to today)"},
+		},
+		CLICommand:    "wellness",
+		CLISubcommand: "summary",
+		MCPTool:       "get_daily_summary",
+		Short:         "Get the daily summary for a date",
+		Long:          "Get the overview of a day: steps, calories, floors, resting heart rate, stress averages, body battery highs and lows, and intensity minutes. Start here for \"how was my day\" questions, then use the dedicated tools for details.",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Wellness.GetDailySummary(ctx, args.Date("date"))
+		},
+	},
 	{
 		Name:       "GetDailyStress",
 		Service:    "Wellness",
@@ -76,6 +110,49 @@ var WellnessEndpoints = []endpoint.Endpoint{
 			return client.Wellness.GetDailyHeartRate(ctx, args.Date("date"))
 		},
 	},
+	{
+		Name:       "GetHeartRateForDate",
+		Service:    "Wellness",
+		Cassette:   "mobile_gateway",
+		Path:       "/mobile-gateway/heartRate/forDate/{date}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to get heart rate data for (YYYY-MM-DD, defaults to today)"},
+		},
+		CLICommand:    "wellness",
+		CLISubcommand: "heart-rate-mobile",
+		Short:         "Get heart rate data for a date from the mobile gateway",
+		Long:          "Get heart rate data for a day from the mobile app gateway, with the same content as heart-rate",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Wellness.GetHeartRateForDate(ctx, args.Date("date"))
+		},
+	},
+	{
+		Name:       "GetRestingHeartRateRange",
+		Service:    "Wellness",
+		Cassette:   "wellness_rhr",
+		Path:       "/userstats-service/wellness/daily/{displayName}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for resting heart rate"},
+		},
+		CLICommand:    "wellness",
+		CLISubcommand: "rhr",
+		MCPTool:       "get_resting_heart_rate_range",
+		Short:         "Get resting heart rate for a date range",
+		Long:          "Get the resting heart rate (bpm) of each day of a date range in one request; use this rather than get_heart_rate to follow resting heart rate over weeks or months",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Wellness.GetRestingHeartRateRange(ctx, args.Date("start"), args.Date("end"))
+		},
+	},
 	{
 		Name:       "GetDailySpO2",
 		Service:    "Wellness",
@@ -142,4 +219,160 @@ var WellnessEndpoints = []endpoint.Endpoint{
 			return client.Wellness.GetDailyIntensityMinutes(ctx, args.Date("date"))
 		},
 	},
+	{
+		Name:       "GetDailyStressStats",
+		Service:    "Wellness",
+		Cassette:   "wellness_stats",
+		Path:       "/usersummary-service/stats/stress/daily/{start}/{end}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for daily stress stats"},
+		},
+		CLICommand:    "wellness",
+		CLISubcommand: "stress-stats",
+		MCPTool:       "get_daily_stress_stats",
+		Short:         "Get daily stress stats for a date range",
+		Long:          "Get the overall stress level and time spent at rest, low, medium and high stress (seconds) for each day of a date range; ranges longer than 28 days are fetched in several requests",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Wellness.GetDailyStressStats(ctx, args.Date("start"), args.Date("end"))
+		},
+	},
+	{
+		Name:       "GetWeeklyStressStats",
+		Service:    "Wellness",
+		Cassette:   "wellness_stats",
+		Path:       "/usersummary-service/stats/stress/weekly/{end}/{weeks}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "End date of the last week (YYYY-MM-DD, defaults to today)"},
+			{Name: "weeks", Type: endpoint.ParamTypeInt, Required: false, Description: "Number of weeks (defaults to 4)"},
+		},
+		CLICommand:    "wellness",
+		CLISubcommand: "stress-weekly",
+		MCPTool:       "get_weekly_stress_stats",
+		Short:         "Get weekly stress stats",
+		Long:          "Get the average stress level per week for the given number of weeks; more than 52 weeks are fetched in several requests",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Wellness.GetWeeklyStressStats(ctx, args.Date("date"), statsWeeks(args))
+		},
+	},
+	{
+		Name:       "GetDailyIntensityMinutesStats",
+		Service:    "Wellness",
+		Cassette:   "wellness_stats",
+		Path:       "/usersummary-service/stats/im/daily/{start}/{end}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for daily intensity minutes"},
+		},
+		CLICommand:    "wellness",
+		CLISubcommand: "im-stats",
+		MCPTool:       "get_daily_intensity_minutes_stats",
+		Short:         "Get daily intensity minutes for a date range",
+		Long:          "Get moderate and vigorous intensity minutes for each day of a date range; ranges longer than 28 days are fetched in several requests",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Wellness.GetDailyIntensityMinutesStats(ctx, args.Date("start"), args.Date("end"))
+		},
+	},
+	{
+		Name:       "GetWeeklyIntensityMinutesStats",
+		Service:    "Wellness",
+		Cassette:   "wellness_stats",
+		Path:       "/usersummary-service/stats/im/weekly/{start}/{end}",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "End date of the last week (YYYY-MM-DD, defaults to today)"},
+			{Name: "weeks", Type: endpoint.ParamTypeInt, Required: false, Description: "Number of weeks (defaults to 4)"},
+		},
+		CLICommand:    "wellness",
+		CLISubcommand: "im-weekly",
+		MCPTool:       "get_weekly_intensity_minutes_stats",
+		Short:         "Get weekly intensity minutes",
+		Long:          "Get moderate and vigorous intensity minutes per week for the given number of weeks; more than 52 weeks are fetched in several requests",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Wellness.GetWeeklyIntensityMinutesStats(ctx, args.Date("date"), statsWeeks(args))
+		},
+	},
+	{
+		Name:       "GetBodyBatteryReports",
+		Service:    "Wellness",
+		Cassette:   "wellness_charts",
+		Path:       "/wellness-service/wellness/bodyBattery/reports/daily",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "range", Type: endpoint.ParamTypeDateRange, Required: false, Description: "Date range for body battery reports"},
+		},
+		CLICommand:    "wellness",
+		CLISubcommand: "body-battery-reports",
+		MCPTool:       "get_body_battery_reports",
+		Short:         "Get daily body battery reports for a date range",
+		Long:          "Get the body battery charged and drained each day of a date range with the timestamped body battery levels; ranges longer than 28 days are fetched in several requests",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Wellness.GetBodyBatteryReports(ctx, args.Date("start"), args.Date("end"))
+		},
+	},
+	{
+		Name:       "GetDailyFloors",
+		Service:    "Wellness",
+		Cassette:   "wellness_charts",
+		Path:       "/wellness-service/wellness/floorsChartData/daily",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to get floors for (YYYY-MM-DD, defaults to today)"},
+		},
+		CLICommand:    "wellness",
+		CLISubcommand: "floors",
+		MCPTool:       "get_floors",
+		Short:         "Get floors climbed for a date",
+		Long:          "Get the floors climbed and descended throughout the day, per interval with start and end times",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Wellness.GetDailyFloors(ctx, args.Date("date"))
+		},
+	},
+	{
+		Name:       "GetDailyEvents",
+		Service:    "Wellness",
+		Cassette:   "wellness_charts",
+		Path:       "/wellness-service/wellness/dailyEvents",
+		HTTPMethod: "GET",
+		Params: []endpoint.Param{
+			{Name: "date", Type: endpoint.ParamTypeDate, Required: false, Description: "Date to get events for (YYYY-MM-DD, defaults to today)"},
+		},
+		CLICommand:    "wellness",
+		CLISubcommand: "events",
+		MCPTool:       "get_daily_events",
+		Short:         "Get automatically detected activities for a date",
+		Long:          "Get the activities detected automatically during the day (Move IQ), such as walks or runs that were not recorded, with their start time and duration",
+		Handler: func(ctx context.Context, c any, args *endpoint.HandlerArgs) (any, error) {
+			client, ok := c.(*garmin.Client)
+			if !ok {
+				return nil, fmt.Errorf("handler received invalid client type: %T, expected *garmin.Client", c)
+			}
+			return client.Wellness.GetDailyEvents(ctx, args.Date("date"))
+		},
+	},
 }
@@ -24,6 +24,7 @@ type Param struct {
 	Type        ParamType
 	Required    bool
 	Description string
+	CLIOnly     bool // Not exposed to MCP clients (e.g. paths of local files to read)
 }
 
 // BodyConfig describes a complex JSON request body.
@@ -55,6 +56,9 @@ type Endpoint struct {
 	CLICommand    string
 	CLISubcommand string
 	CLIAliases    []string
+	// Confirm returns the question asked before running the CLI command (e.g. for
+	// deletes). The command is aborted unless the user answers yes or passes --yes.
+	Confirm func(args *HandlerArgs) string
 
 	// MCP configuration
 	MCPTool string
@@ -40,6 +40,9 @@ func (g *MCPGenerator) registerTool(s *server.MCPServer, ep *Endpoint) {
 	}
 
 	for _, p := range ep.Params {
+		if p.CLIOnly {
+			continue
+		}
 		opts = append(opts, g.paramToMCPOptions(p)...)
 	}
 
@@ -142,6 +145,9 @@ func (g *MCPGenerator) parseRequest(request mcp.CallToolRequest, ep *Endpoint) (
 	args := &HandlerArgs{Params: make(map[string]any)}
 
 	for _, p := range ep.Params {
+		if p.CLIOnly {
+			continue
+		}
 		switch p.Type {
 		case ParamTypeString:
 			if v, err := request.RequireString(p.Name); err == nil {
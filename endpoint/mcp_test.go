@@ -5,6 +5,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
@@ -175,3 +176,45 @@ func TestMCPGenerator_RequiredParam(t *testing.T) {
 		t.Fatalf("expected 1 tool, got %d", len(tools))
 	}
 }
+
+func TestMCPGenerator_SkipsCLIOnlyParams(t *testing.T) {
+	r := NewRegistry()
+	var received *HandlerArgs
+	r.Register(Endpoint{
+		Name:    "RunQuery",
+		MCPTool: "run_query",
+		Long:    "Run a query",
+		Params: []Param{
+			{Name: "query", Type: ParamTypeString, Description: "The query"},
+			{Name: "query-file", Type: ParamTypeString, Description: "File with the query", CLIOnly: true},
+		},
+		Handler: func(_ context.Context, _ any, args *HandlerArgs) (any, error) {
+			received = args
+			return struct{}{}, nil
+		},
+	})
+
+	s := server.NewMCPServer("test", "1.0.0", server.WithToolCapabilities(true))
+	gen := NewMCPGenerator(r, nil)
+	gen.RegisterTools(s)
+
+	tool, ok := s.ListTools()["run_query"]
+	if !ok {
+		t.Fatal("expected 'run_query' tool to be registered")
+	}
+	if _, ok := tool.Tool.InputSchema.Properties["query-file"]; ok {
+		t.Error("expected CLI-only param not to be exposed")
+	}
+
+	var request mcp.CallToolRequest
+	request.Params.Arguments = map[string]any{"query": "{a}", "query-file": "/etc/passwd"}
+	if _, err := tool.Handler(context.Background(), request); err != nil {
+		t.Fatalf("handler failed: %v", err)
+	}
+	if _, ok := received.Params["query-file"]; ok {
+		t.Error("expected CLI-only param to be ignored in MCP requests")
+	}
+	if received.Params["query"] != "{a}" {
+		t.Errorf("query = %v, want {a}", received.Params["query"])
+	}
+}
@@ -15,6 +15,9 @@ var (
 	ErrRateLimited        = errors.New("garmin: rate limited, retry later")
 	ErrMaxRetriesExceeded = errors.New("garmin: max retries exceeded")
 	ErrNotFound           = errors.New("garmin: resource not found")
+
+	ErrDuplicateUpload         = errors.New("garmin: activity already uploaded")
+	ErrUnsupportedUploadFormat = errors.New("garmin: unsupported upload file format")
 )
 
 type APIError struct {
@@ -67,6 +70,10 @@ func IsServerError(err error) bool {
 	return false
 }
 
+func IsDuplicateUpload(err error) bool {
+	return errors.Is(err, ErrDuplicateUpload)
+}
+
 func IsRetryable(err error) bool {
 	return IsRateLimited(err) || IsServerError(err)
 }
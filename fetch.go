@@ -5,9 +5,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
+	"time"
 )
 
 // RawSetter is implemented by response types that store raw JSON.
@@ -47,7 +50,8 @@ func fetch[T any, PT interface {
 }
 
 // send performs a POST/PUT/PATCH request with a JSON body and unmarshals the response into T.
-// Returns APIError if the response status is not in the 2xx range.
+// Returns APIError if the response status is not in the 2xx range. An empty response
+// body (e.g. 204 No Content) yields a zero T.
 // Usage: send[Workout, *Workout](ctx, client, http.MethodPost, path, body)
 func send[T any, PT interface {
 	*T
@@ -74,6 +78,9 @@ func send[T any, PT interface {
 	}
 
 	result := new(T)
+	if len(raw) == 0 {
+		return result, nil
+	}
 	if err := json.Unmarshal(raw, result); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
@@ -128,3 +135,113 @@ func sendEmpty(ctx context.Context, c *Client, method, path string) error {
 
 	return nil
 }
+
+// ignoredResponse is used with send when the response body is not needed.
+type ignoredResponse struct{}
+
+// UnmarshalJSON discards the response body.
+func (*ignoredResponse) UnmarshalJSON([]byte) error { return nil }
+
+// SetRaw discards the raw response.
+func (*ignoredResponse) SetRaw(json.RawMessage) {}
+
+// paginate returns an iterator over all items of a paginated list endpoint.
+// fetchPage is called with increasing start indexes (beginning at first) until it
+// returns fewer than pageSize items. ErrNotFound ends the iteration without error.
+func paginate[T any](ctx context.Context, first, pageSize int, fetchPage func(ctx context.Context, start, limit int) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for start := first; ; start += pageSize {
+			items, err := fetchPage(ctx, start, pageSize)
+			if err != nil {
+				if !errors.Is(err, ErrNotFound) {
+					var zero T
+					yield(zero, err)
+				}
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(items) < pageSize {
+				return
+			}
+		}
+	}
+}
+
+// dateRange is an inclusive range of calendar days.
+type dateRange struct {
+	Start, End time.Time
+}
+
+// splitDateRange splits the inclusive range [start, end] into consecutive chunks
+// of at most maxDays days. Returns a single chunk if maxDays is not positive.
+func splitDateRange(start, end time.Time, maxDays int) []dateRange {
+	if end.Before(start) {
+		start, end = end, start
+	}
+	if maxDays <= 0 {
+		return []dateRange{{Start: start, End: end}}
+	}
+	var chunks []dateRange
+	for from := start; !from.After(end); from = from.AddDate(0, 0, maxDays) {
+		to := from.AddDate(0, 0, maxDays-1)
+		if to.After(end) {
+			to = end
+		}
+		chunks = append(chunks, dateRange{Start: from, End: to})
+	}
+	return chunks
+}
+
+// fetchDateRange fetches a stats endpoint returning a JSON array over [start, end],
+// splitting the range into chunks of at most maxDays days and merging the arrays
+// into T. Chunks without data are skipped; ErrNotFound is returned if none had data.
+func fetchDateRange[T any, PT interface {
+	*T
+	RawSetter
+}](ctx context.Context, c *Client, start, end time.Time, maxDays int, pathFor func(start, end time.Time) string) (*T, error) {
+	merged := []json.RawMessage{}
+	found := false
+	for _, chunk := range splitDateRange(start, end, maxDays) {
+		resp, err := c.doAPI(ctx, http.MethodGet, pathFor(chunk.Start, chunk.End), http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+		raw, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return nil, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Body: raw}
+		}
+
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, err
+		}
+		merged = append(merged, items...)
+		found = true
+	}
+	if !found {
+		return nil, ErrNotFound
+	}
+
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	result := new(T)
+	if err := json.Unmarshal(raw, result); err != nil {
+		return nil, err
+	}
+	PT(result).SetRaw(raw)
+
+	return result, nil
+}
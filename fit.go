@@ -0,0 +1,183 @@
+// fit.go
+package garmin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"time"
+)
+
+// FIT (Flexible and Interoperable Data Transfer) is the binary format Garmin devices
+// use for activities and measurements. Only what is needed to upload a weight scale
+// reading is implemented here.
+
+// fitEpoch is the start of FIT timestamps (1989-12-31T00:00:00Z) in Unix seconds.
+const fitEpoch = 631065600
+
+const (
+	fitHeaderSize      = 14
+	fitProtocolVersion = 0x10 // 1.0
+	fitProfileVersion  = 2100 // 21.00
+)
+
+// FIT global message numbers.
+const (
+	fitMesgFileID      = 0
+	fitMesgDeviceInfo  = 23
+	fitMesgWeightScale = 30
+	fitMesgFileCreator = 49
+)
+
+// FIT base types.
+const (
+	fitEnum    = 0x00
+	fitUint8   = 0x02
+	fitUint16  = 0x84
+	fitUint32  = 0x86
+	fitUint32z = 0x8C
+)
+
+// fitFileTypeWeight is the file_id type of a weight file.
+const fitFileTypeWeight = 9
+
+// fitManufacturerDevelopment is the manufacturer ID reserved for development.
+const fitManufacturerDevelopment = 255
+
+// fitField is a field of a FIT message.
+type fitField struct {
+	num      byte
+	baseType byte
+	value    uint64
+}
+
+// size returns the size of the field in bytes.
+func (f fitField) size() byte {
+	switch f.baseType {
+	case fitEnum, fitUint8:
+		return 1
+	case fitUint16:
+		return 2
+	default:
+		return 4
+	}
+}
+
+// fitEncoder builds the records of a FIT file.
+type fitEncoder struct {
+	records bytes.Buffer
+}
+
+// message writes a definition record followed by a single data record.
+// Every message uses local message type 0, which is redefined each time.
+func (e *fitEncoder) message(global uint16, fields []fitField) {
+	// Definition record: header, reserved, architecture (little endian), global number, fields.
+	e.records.Write([]byte{0x40, 0, 0})
+	_ = binary.Write(&e.records, binary.LittleEndian, global)
+	e.records.WriteByte(byte(len(fields)))
+	for _, f := range fields {
+		e.records.Write([]byte{f.num, f.size(), f.baseType})
+	}
+
+	e.records.WriteByte(0x00)
+	for _, f := range fields {
+		switch f.size() {
+		case 1:
+			e.records.WriteByte(byte(f.value))
+		case 2:
+			_ = binary.Write(&e.records, binary.LittleEndian, uint16(f.value))
+		default:
+			_ = binary.Write(&e.records, binary.LittleEndian, uint32(f.value))
+		}
+	}
+}
+
+// bytes returns the complete FIT file: header, records and trailing CRC.
+func (e *fitEncoder) bytes() []byte {
+	var out bytes.Buffer
+	out.WriteByte(fitHeaderSize)
+	out.WriteByte(fitProtocolVersion)
+	_ = binary.Write(&out, binary.LittleEndian, uint16(fitProfileVersion))
+	_ = binary.Write(&out, binary.LittleEndian, uint32(e.records.Len()))
+	out.WriteString(".FIT")
+	_ = binary.Write(&out, binary.LittleEndian, fitCRC(out.Bytes()))
+
+	out.Write(e.records.Bytes())
+	_ = binary.Write(&out, binary.LittleEndian, fitCRC(out.Bytes()))
+	return out.Bytes()
+}
+
+// fitCRCTable is the nibble lookup table of the FIT CRC-16.
+var fitCRCTable = [16]uint16{
+	0x0000, 0xCC01, 0xD801, 0x1400, 0xF001, 0x3C00, 0x2800, 0xE401,
+	0xA001, 0x6C00, 0x7800, 0xB401, 0x5000, 0x9C01, 0x8801, 0x4400,
+}
+
+// fitCRC computes the FIT CRC-16 of data.
+func fitCRC(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		tmp := fitCRCTable[crc&0xF]
+		crc = (crc>>4)&0x0FFF ^ tmp ^ fitCRCTable[b&0xF]
+		tmp = fitCRCTable[crc&0xF]
+		crc = (crc>>4)&0x0FFF ^ tmp ^ fitCRCTable[(b>>4)&0xF]
+	}
+	return crc
+}
+
+// fitTimestamp converts t to a FIT timestamp.
+func fitTimestamp(t time.Time) uint64 {
+	return uint64(t.Unix() - fitEpoch)
+}
+
+// fitScaled converts a value to its scaled integer representation.
+func fitScaled(value, scale float64) uint64 {
+	return uint64(math.Round(value * scale))
+}
+
+// encodeWeighInFIT encodes a weigh-in as a FIT weight file.
+// Composition values that are not set are left out of the weight_scale message.
+func encodeWeighInFIT(w *WeighIn, timestamp time.Time) []byte {
+	ts := fitTimestamp(timestamp)
+
+	var e fitEncoder
+	e.message(fitMesgFileID, []fitField{
+		{num: 0, baseType: fitEnum, value: fitFileTypeWeight},
+		{num: 1, baseType: fitUint16, value: fitManufacturerDevelopment},
+		{num: 2, baseType: fitUint16, value: 0},
+		{num: 3, baseType: fitUint32z, value: 1},
+		{num: 4, baseType: fitUint32, value: ts},
+	})
+	e.message(fitMesgFileCreator, []fitField{
+		{num: 0, baseType: fitUint16, value: 0},
+		{num: 1, baseType: fitUint8, value: 0},
+	})
+	e.message(fitMesgDeviceInfo, []fitField{
+		{num: 253, baseType: fitUint32, value: ts},
+		{num: 2, baseType: fitUint16, value: fitManufacturerDevelopment},
+	})
+
+	fields := []fitField{
+		{num: 253, baseType: fitUint32, value: ts},
+		{num: 0, baseType: fitUint16, value: fitScaled(w.WeightKg, 100)},
+	}
+	optional := []struct {
+		num   byte
+		value *float64
+		scale float64
+	}{
+		{1, w.BodyFat, 100},
+		{2, w.BodyWater, 100},
+		{4, w.BoneMass, 100},
+		{5, w.MuscleMass, 100},
+		{13, w.BMI, 10},
+	}
+	for _, o := range optional {
+		if o.value != nil {
+			fields = append(fields, fitField{num: o.num, baseType: fitUint16, value: fitScaled(*o.value, o.scale)})
+		}
+	}
+	e.message(fitMesgWeightScale, fields)
+
+	return e.bytes()
+}
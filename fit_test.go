@@ -0,0 +1,117 @@
+// fit_test.go
+package garmin
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+// decodeFIT parses the data records of a FIT file produced by fitEncoder into
+// messages keyed by global message number, then field number.
+func decodeFIT(t *testing.T, data []byte) map[uint16]map[byte]uint64 {
+	t.Helper()
+	if len(data) < fitHeaderSize+2 {
+		t.Fatalf("FIT file too short: %d bytes", len(data))
+	}
+	if data[0] != fitHeaderSize || string(data[8:12]) != ".FIT" {
+		t.Fatalf("invalid FIT header: % x", data[:fitHeaderSize])
+	}
+	if crc := binary.LittleEndian.Uint16(data[12:14]); crc != fitCRC(data[:12]) {
+		t.Errorf("header CRC = %#04x, want %#04x", crc, fitCRC(data[:12]))
+	}
+	if fitCRC(data) != 0 {
+		t.Error("file CRC does not match")
+	}
+	size := int(binary.LittleEndian.Uint32(data[4:8]))
+	if size != len(data)-fitHeaderSize-2 {
+		t.Fatalf("data size = %d, want %d", size, len(data)-fitHeaderSize-2)
+	}
+
+	type definition struct {
+		global uint16
+		fields [][2]byte // number, size
+	}
+	var def *definition
+	messages := map[uint16]map[byte]uint64{}
+	records := data[fitHeaderSize : fitHeaderSize+size]
+	for i := 0; i < len(records); {
+		header := records[i]
+		i++
+		if header&0x40 != 0 {
+			def = &definition{global: binary.LittleEndian.Uint16(records[i+2 : i+4])}
+			n := int(records[i+4])
+			i += 5
+			for range n {
+				def.fields = append(def.fields, [2]byte{records[i], records[i+1]})
+				i += 3
+			}
+			continue
+		}
+		if def == nil {
+			t.Fatal("data record before definition")
+		}
+		values := map[byte]uint64{}
+		for _, f := range def.fields {
+			var v uint64
+			for b := int(f[1]) - 1; b >= 0; b-- {
+				v = v<<8 | uint64(records[i+b])
+			}
+			values[f[0]] = v
+			i += int(f[1])
+		}
+		messages[def.global] = values
+	}
+	return messages
+}
+
+func TestFitCRC(t *testing.T) {
+	// The CRC of data followed by its own little-endian CRC is zero.
+	data := []byte("123456789")
+	crc := fitCRC(data)
+	if crc != 0xBB3D {
+		t.Errorf("fitCRC = %#04x, want 0xbb3d", crc)
+	}
+	if fitCRC(append(data, byte(crc), byte(crc>>8))) != 0 {
+		t.Error("CRC over data and CRC should be zero")
+	}
+}
+
+func TestEncodeWeighInFIT(t *testing.T) {
+	bodyFat, muscleMass, bmi := 18.5, 33.1, 22.4
+	timestamp := time.Date(2026, 1, 27, 7, 30, 0, 0, time.FixedZone("CET", 3600))
+	data := encodeWeighInFIT(&WeighIn{
+		WeightKg:   72.5,
+		BodyFat:    &bodyFat,
+		MuscleMass: &muscleMass,
+		BMI:        &bmi,
+	}, timestamp)
+
+	messages := decodeFIT(t, data)
+	fileID, ok := messages[fitMesgFileID]
+	if !ok || fileID[0] != fitFileTypeWeight {
+		t.Errorf("file_id = %v, want type %d", fileID, fitFileTypeWeight)
+	}
+
+	weight, ok := messages[fitMesgWeightScale]
+	if !ok {
+		t.Fatal("missing weight_scale message")
+	}
+	want := map[byte]uint64{
+		253: uint64(timestamp.Unix() - fitEpoch),
+		0:   7250,
+		1:   1850,
+		5:   3310,
+		13:  224,
+	}
+	for num, value := range want {
+		if weight[num] != value {
+			t.Errorf("weight_scale field %d = %d, want %d", num, weight[num], value)
+		}
+	}
+	for _, num := range []byte{2, 4} {
+		if _, ok := weight[num]; ok {
+			t.Errorf("weight_scale field %d should be omitted when not set", num)
+		}
+	}
+}
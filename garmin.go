@@ -8,6 +8,7 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"sync"
 )
 
 const (
@@ -47,10 +48,16 @@ type Client struct {
 	FitnessAge      *FitnessAgeService
 	FitnessStats    *FitnessStatsService
 	Courses         *CourseService
+	TrainingPlans   *TrainingPlanService
+	PeriodicHealth  *PeriodicHealthService
+	Golf            *GolfService
+	Lifestyle       *LifestyleService
+	GraphQL         *GraphQLService
 
 	opts      Options
 	transport *httpTransport
 	auth      *authState
+	authMu    sync.Mutex // guards token refresh for concurrent requests
 }
 
 // New creates a new Garmin client with the provided options.
@@ -94,6 +101,11 @@ func New(opts Options) *Client {
 	c.FitnessAge = &FitnessAgeService{client: c}
 	c.FitnessStats = &FitnessStatsService{client: c}
 	c.Courses = &CourseService{client: c}
+	c.TrainingPlans = &TrainingPlanService{client: c}
+	c.PeriodicHealth = &PeriodicHealthService{client: c}
+	c.Golf = &GolfService{client: c}
+	c.Lifestyle = &LifestyleService{client: c}
+	c.GraphQL = &GraphQLService{client: c}
 
 	return c
 }
@@ -108,27 +120,41 @@ func (c *Client) LoadSession(r io.Reader) error {
 	return c.auth.load(r)
 }
 
-// doAPI performs an authenticated API request to Garmin Connect.
-//
-//nolint:unparam // method will be used for POST/PUT/DELETE in future service implementations
-func (c *Client) doAPI(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+// accessToken returns a valid OAuth2 access token, refreshing it if expired.
+// Safe for concurrent use.
+func (c *Client) accessToken(ctx context.Context) (string, error) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
 	if !c.auth.isAuthenticated() {
-		return nil, ErrNotAuthenticated
+		return "", ErrNotAuthenticated
 	}
 
 	if c.auth.isExpired() {
 		if err := c.refreshOAuth2(ctx); err != nil {
-			return nil, err
+			return "", err
 		}
 	}
 
+	return c.auth.OAuth2AccessToken, nil
+}
+
+// doAPI performs an authenticated API request to Garmin Connect.
+//
+//nolint:unparam // method will be used for POST/PUT/DELETE in future service implementations
+func (c *Client) doAPI(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	url := fmt.Sprintf("https://connectapi.%s%s", c.auth.Domain, path)
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.auth.OAuth2AccessToken)
+	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("User-Agent", "GCM-iOS-5.19.1.2")
 
 	return c.transport.do(req)
@@ -136,14 +162,9 @@ func (c *Client) doAPI(ctx context.Context, method, path string, body io.Reader)
 
 // doAPIWithBody performs an authenticated API request with a JSON body.
 func (c *Client) doAPIWithBody(ctx context.Context, method, path string, body io.Reader) (*http.Response, error) {
-	if !c.auth.isAuthenticated() {
-		return nil, ErrNotAuthenticated
-	}
-
-	if c.auth.isExpired() {
-		if err := c.refreshOAuth2(ctx); err != nil {
-			return nil, err
-		}
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	url := fmt.Sprintf("https://connectapi.%s%s", c.auth.Domain, path)
@@ -152,7 +173,7 @@ func (c *Client) doAPIWithBody(ctx context.Context, method, path string, body io
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.auth.OAuth2AccessToken)
+	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", "GCM-iOS-5.19.1.2")
 	req.Header.Set("nk", "NT")
@@ -162,14 +183,9 @@ func (c *Client) doAPIWithBody(ctx context.Context, method, path string, body io
 
 // doAPIMultipart performs an authenticated multipart/form-data upload.
 func (c *Client) doAPIMultipart(ctx context.Context, path, fieldName, fileName string, content io.Reader) (*http.Response, error) {
-	if !c.auth.isAuthenticated() {
-		return nil, ErrNotAuthenticated
-	}
-
-	if c.auth.isExpired() {
-		if err := c.refreshOAuth2(ctx); err != nil {
-			return nil, err
-		}
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	var buf bytes.Buffer
@@ -191,7 +207,7 @@ func (c *Client) doAPIMultipart(ctx context.Context, path, fieldName, fileName s
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+c.auth.OAuth2AccessToken)
+	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Content-Type", w.FormDataContentType())
 	req.Header.Set("User-Agent", "GCM-iOS-5.19.1.2")
 	req.Header.Set("nk", "NT")
@@ -94,6 +94,11 @@ func TestAllServicesInitialized(t *testing.T) {
 		{"PersonalRecords", client.PersonalRecords},
 		{"Steps", client.Steps},
 		{"UserProfile", client.UserProfile},
+		{"TrainingPlans", client.TrainingPlans},
+		{"PeriodicHealth", client.PeriodicHealth},
+		{"Golf", client.Golf},
+		{"Lifestyle", client.Lifestyle},
+		{"GraphQL", client.GraphQL},
 	}
 
 	for _, s := range services {
@@ -0,0 +1,41 @@
+// helpers_test.go
+package garmin
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/llehouerou/go-garmin/testutil"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newFakeClient returns an authenticated client whose requests are served by handler.
+func newFakeClient(t *testing.T, handler func(*http.Request) (int, []byte)) *Client {
+	t.Helper()
+	client := New(Options{
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			status, body := handler(req)
+			return &http.Response{
+				StatusCode: status,
+				Status:     http.StatusText(status),
+				Body:       io.NopCloser(bytes.NewReader(body)),
+				Request:    req,
+			}, nil
+		})},
+		// No real server behind the fake transport, so don't throttle
+		RateLimit: &RateLimitConfig{RequestsPerMinute: 60000, BurstSize: 100},
+	})
+	if err := client.LoadSession(strings.NewReader(testutil.FakeSessionJSON())); err != nil {
+		t.Fatalf("failed to load fake session: %v", err)
+	}
+	return client
+}
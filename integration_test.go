@@ -73,6 +73,31 @@ func TestIntegration_Sleep_GetDaily(t *testing.T) {
 	}
 }
 
+func TestIntegration_Sleep_GetScoreStats(t *testing.T) {
+	skipIfNoCassette(t, "sleep_scores")
+
+	rec, err := testutil.NewRecorder("sleep_scores", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	stats, err := client.Sleep.GetScoreStats(ctx, date.AddDate(0, 0, -6), date)
+	if err != nil {
+		t.Fatalf("GetScoreStats failed: %v", err)
+	}
+
+	for _, day := range stats.IndividualStats {
+		if day.CalendarDate == "" {
+			t.Error("expected CalendarDate to be set")
+		}
+	}
+}
+
 func TestIntegration_Wellness_GetDailyStress(t *testing.T) {
 	skipIfNoCassette(t, "wellness_stress")
 
@@ -1177,6 +1202,36 @@ func TestIntegration_Device_GetPrimaryTrainingDevice(t *testing.T) {
 	}
 }
 
+func TestIntegration_Device_GetLastUsed(t *testing.T) {
+	skipIfNoCassette(t, "devices_extra")
+
+	rec, err := testutil.NewRecorder("devices_extra", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+
+	device, err := client.Devices.GetLastUsed(ctx)
+	if err != nil {
+		t.Fatalf("GetLastUsed failed: %v", err)
+	}
+
+	if device.UserDeviceID == 0 {
+		t.Error("expected UserDeviceID to be set")
+	}
+	if device.LastUsedDeviceName == "" {
+		t.Error("expected LastUsedDeviceName to be set")
+	}
+
+	// Verify RawJSON is available
+	if device.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+}
+
 func TestIntegration_Wellness_GetDailySpO2(t *testing.T) {
 	skipIfNoCassette(t, "wellness_extended")
 
@@ -1934,3 +1989,533 @@ func TestIntegration_Calendar_Get(t *testing.T) {
 		t.Error("expected RawJSON to be available")
 	}
 }
+
+func TestIntegration_Gear_List(t *testing.T) {
+	skipIfNoCassette(t, "gear")
+
+	rec, err := testutil.NewRecorder("gear", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+
+	// User profile PK from the recorded cassette (anonymized to 12345678)
+	gear, err := client.Gear.List(ctx, 12345678)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	if len(gear.Items) == 0 {
+		t.Fatal("expected gear, got none")
+	}
+
+	first := gear.Items[0]
+	if first.UUID == "" {
+		t.Error("expected UUID to be set")
+	}
+	if first.GearTypeName == "" {
+		t.Error("expected GearTypeName to be set")
+	}
+
+	if gear.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+
+	stats, err := client.Gear.GetStats(ctx, first.UUID)
+	if err != nil {
+		t.Fatalf("GetStats failed: %v", err)
+	}
+	if stats.UUID != first.UUID {
+		t.Errorf("stats UUID = %s, want %s", stats.UUID, first.UUID)
+	}
+}
+
+func TestIntegration_Goal_List(t *testing.T) {
+	skipIfNoCassette(t, "goals")
+
+	rec, err := testutil.NewRecorder("goals", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+
+	goals, err := client.Goals.List(ctx, GoalStatusActive)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	for _, goal := range goals.Items {
+		if goal.GoalType == "" {
+			t.Error("expected GoalType to be set")
+		}
+	}
+
+	if goals.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+}
+
+func TestIntegration_Badge_GetEarned(t *testing.T) {
+	skipIfNoCassette(t, "badges")
+
+	rec, err := testutil.NewRecorder("badges", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+
+	badges, err := client.Badges.GetEarned(ctx)
+	if err != nil {
+		t.Fatalf("GetEarned failed: %v", err)
+	}
+
+	if len(badges.Items) == 0 {
+		t.Fatal("expected earned badges, got none")
+	}
+	if badges.Items[0].BadgeKey == "" {
+		t.Error("expected BadgeKey to be set")
+	}
+
+	if badges.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+}
+
+func TestIntegration_Badge_CompletedChallenges(t *testing.T) {
+	skipIfNoCassette(t, "badges")
+
+	rec, err := testutil.NewRecorder("badges", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+
+	count := 0
+	for challenge, err := range client.Badges.CompletedChallenges(ctx) {
+		if err != nil {
+			t.Fatalf("CompletedChallenges failed: %v", err)
+		}
+		if challenge.BadgeChallengeName == "" {
+			t.Error("expected BadgeChallengeName to be set")
+		}
+		count++
+	}
+
+	if count == 0 {
+		t.Error("expected completed challenges, got none")
+	}
+}
+
+func TestIntegration_Hydration_GetDaily(t *testing.T) {
+	skipIfNoCassette(t, "hydration")
+
+	rec, err := testutil.NewRecorder("hydration", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	hydration, err := client.Hydration.GetDaily(ctx, date)
+	if err != nil {
+		t.Fatalf("GetDaily failed: %v", err)
+	}
+
+	if hydration.CalendarDate != "2026-01-27" {
+		t.Errorf("CalendarDate = %s, want 2026-01-27", hydration.CalendarDate)
+	}
+
+	// Verify RawJSON is available
+	if hydration.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+}
+
+func TestIntegration_Hydration_GetStats(t *testing.T) {
+	skipIfNoCassette(t, "hydration")
+
+	rec, err := testutil.NewRecorder("hydration", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	endDate := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+	startDate := endDate.AddDate(0, 0, -6)
+
+	stats, err := client.Hydration.GetStats(ctx, startDate, endDate)
+	if err != nil {
+		t.Fatalf("GetStats failed: %v", err)
+	}
+
+	if len(stats.Items) == 0 {
+		t.Error("expected hydration stats to have data")
+	}
+}
+
+func TestIntegration_BloodPressure_GetRange(t *testing.T) {
+	skipIfNoCassette(t, "bloodpressure")
+
+	rec, err := testutil.NewRecorder("bloodpressure", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	endDate := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+	startDate := endDate.AddDate(0, 0, -30)
+
+	bp, err := client.BloodPressure.GetRange(ctx, startDate, endDate)
+	if err != nil {
+		t.Fatalf("GetRange failed: %v", err)
+	}
+
+	if bp.From == "" {
+		t.Error("expected From to be set")
+	}
+	for _, m := range bp.Measurements() {
+		if m.Systolic == 0 || m.Diastolic == 0 {
+			t.Errorf("expected systolic and diastolic to be set, got %d/%d", m.Systolic, m.Diastolic)
+		}
+	}
+
+	// Verify RawJSON is available
+	if bp.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+}
+
+func TestIntegration_PersonalRecords_GetAll(t *testing.T) {
+	skipIfNoCassette(t, "personalrecords")
+
+	rec, err := testutil.NewRecorder("personalrecords", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+
+	// Empty display name resolves it from the social profile
+	records, err := client.PersonalRecords.GetAll(ctx, "")
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+
+	for _, pr := range records.Items {
+		if pr.TypeID == 0 {
+			t.Error("expected TypeID to be set")
+		}
+	}
+
+	// Verify RawJSON is available
+	if records.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+}
+
+func TestIntegration_Steps_GetDaily(t *testing.T) {
+	skipIfNoCassette(t, "steps")
+
+	rec, err := testutil.NewRecorder("steps", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	endDate := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+	startDate := endDate.AddDate(0, 0, -6)
+
+	steps, err := client.Steps.GetDaily(ctx, startDate, endDate)
+	if err != nil {
+		t.Fatalf("GetDaily failed: %v", err)
+	}
+
+	if len(steps.Items) == 0 {
+		t.Error("expected daily steps to have data")
+	}
+}
+
+func TestIntegration_Steps_GetIntraday(t *testing.T) {
+	skipIfNoCassette(t, "steps")
+
+	rec, err := testutil.NewRecorder("steps", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	intraday, err := client.Steps.GetIntraday(ctx, "anonymous", date)
+	if err != nil {
+		t.Fatalf("GetIntraday failed: %v", err)
+	}
+
+	if len(intraday.Items) == 0 {
+		t.Error("expected intraday steps to have data")
+	}
+}
+
+func TestIntegration_TrainingPlans_List(t *testing.T) {
+	skipIfNoCassette(t, "trainingplans")
+
+	rec, err := testutil.NewRecorder("trainingplans", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+
+	plans, err := client.TrainingPlans.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	for _, plan := range plans.TrainingPlanList {
+		if plan.TrainingPlanID == 0 {
+			t.Error("expected TrainingPlanID to be set")
+		}
+	}
+}
+
+func TestIntegration_PeriodicHealth_GetDayView(t *testing.T) {
+	skipIfNoCassette(t, "periodichealth")
+
+	rec, err := testutil.NewRecorder("periodichealth", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	dayView, err := client.PeriodicHealth.GetDayView(ctx, date)
+	if err != nil {
+		t.Fatalf("GetDayView failed: %v", err)
+	}
+
+	// Verify RawJSON is available
+	if dayView.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+}
+
+func TestIntegration_Golf_ListScorecards(t *testing.T) {
+	skipIfNoCassette(t, "golf")
+
+	rec, err := testutil.NewRecorder("golf", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+
+	scorecards, err := client.Golf.ListScorecards(ctx, 1, 20)
+	if err != nil {
+		t.Fatalf("ListScorecards failed: %v", err)
+	}
+
+	for _, scorecard := range scorecards.ScorecardSummaries {
+		if scorecard.ID == 0 {
+			t.Error("expected scorecard ID to be set")
+		}
+	}
+}
+
+func TestIntegration_Lifestyle_GetDaily(t *testing.T) {
+	skipIfNoCassette(t, "lifestyle")
+
+	rec, err := testutil.NewRecorder("lifestyle", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	log, err := client.Lifestyle.GetDaily(ctx, date)
+	if err != nil {
+		t.Fatalf("GetDaily failed: %v", err)
+	}
+
+	for _, behavior := range log.DailyLogsReport {
+		if behavior.BehaviorID == 0 {
+			t.Error("expected BehaviorID to be set")
+		}
+	}
+}
+
+func TestIntegration_Wellness_GetDailySummary(t *testing.T) {
+	skipIfNoCassette(t, "dailysummary")
+
+	rec, err := testutil.NewRecorder("dailysummary", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	summary, err := client.Wellness.GetDailySummary(ctx, date)
+	if err != nil {
+		t.Fatalf("GetDailySummary failed: %v", err)
+	}
+
+	if summary.CalendarDate != "2026-01-27" {
+		t.Errorf("CalendarDate = %s, want 2026-01-27", summary.CalendarDate)
+	}
+}
+
+func TestIntegration_Wellness_GetDailyStressStats(t *testing.T) {
+	skipIfNoCassette(t, "wellness_stats")
+
+	rec, err := testutil.NewRecorder("wellness_stats", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	stats, err := client.Wellness.GetDailyStressStats(ctx, date.AddDate(0, 0, -6), date)
+	if err != nil {
+		t.Fatalf("GetDailyStressStats failed: %v", err)
+	}
+
+	if len(stats.Items) == 0 {
+		t.Error("expected at least one daily stress stat")
+	}
+}
+
+func TestIntegration_Wellness_GetDailyFloors(t *testing.T) {
+	skipIfNoCassette(t, "wellness_charts")
+
+	rec, err := testutil.NewRecorder("wellness_charts", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	floors, err := client.Wellness.GetDailyFloors(ctx, date)
+	if err != nil {
+		t.Fatalf("GetDailyFloors failed: %v", err)
+	}
+
+	for _, v := range floors.Values {
+		if v.Time.IsZero() {
+			t.Error("expected every floors interval to have a start time")
+		}
+	}
+}
+
+func TestIntegration_Wellness_GetRestingHeartRateRange(t *testing.T) {
+	skipIfNoCassette(t, "wellness_rhr")
+
+	rec, err := testutil.NewRecorder("wellness_rhr", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	rhr, err := client.Wellness.GetRestingHeartRateRange(ctx, date.AddDate(0, 0, -29), date)
+	if err != nil {
+		t.Fatalf("GetRestingHeartRateRange failed: %v", err)
+	}
+
+	for i := range rhr.Items {
+		if bpm, ok := rhr.Items[i].Value(RestingHeartRateKey); !ok || bpm <= 0 {
+			t.Errorf("resting heart rate on %s = %v, want > 0", rhr.Items[i].CalendarDate, bpm)
+		}
+	}
+}
+
+func TestIntegration_GraphQL_GetHealthDashboard(t *testing.T) {
+	skipIfNoCassette(t, "graphql")
+
+	rec, err := testutil.NewRecorder("graphql", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	dashboard, err := client.GraphQL.GetHealthDashboard(ctx, date.AddDate(0, 0, -6), date)
+	if err != nil {
+		t.Fatalf("GetHealthDashboard failed: %v", err)
+	}
+
+	if dashboard.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+}
+
+func TestIntegration_Wellness_GetHeartRateForDate(t *testing.T) {
+	skipIfNoCassette(t, "mobile_gateway")
+
+	rec, err := testutil.NewRecorder("mobile_gateway", recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatalf("failed to create recorder: %v", err)
+	}
+	defer func() { _ = rec.Stop() }()
+
+	client := newTestClient(t, rec)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	hr, err := client.Wellness.GetHeartRateForDate(ctx, date)
+	if err != nil {
+		t.Fatalf("GetHeartRateForDate failed: %v", err)
+	}
+
+	if hr.CalendarDate != "2026-01-27" {
+		t.Errorf("CalendarDate = %q, want 2026-01-27", hr.CalendarDate)
+	}
+}
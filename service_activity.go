@@ -3,11 +3,13 @@ package garmin
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -556,22 +558,31 @@ type ListOptions struct {
 	Limit int // Maximum number of activities to return
 }
 
-// List retrieves a list of activities.
-func (s *ActivityService) List(ctx context.Context, opts *ListOptions) ([]Activity, error) {
-	start := 0
-	limit := 20
-	if opts != nil {
-		if opts.Start > 0 {
-			start = opts.Start
+// values returns the start index and page size, applying defaults for unset fields.
+func (o *ListOptions) values() (start, limit int) {
+	start = 0
+	limit = 20
+	if o != nil {
+		if o.Start > 0 {
+			start = o.Start
 		}
-		if opts.Limit > 0 {
-			limit = opts.Limit
+		if o.Limit > 0 {
+			limit = o.Limit
 		}
 	}
+	return start, limit
+}
 
+// List retrieves a list of activities.
+func (s *ActivityService) List(ctx context.Context, opts *ListOptions) ([]Activity, error) {
+	start, limit := opts.values()
 	path := fmt.Sprintf("/activitylist-service/activities/search/activities?start=%d&limit=%d", start, limit)
+	return fetchActivities(ctx, s.client, path)
+}
 
-	resp, err := s.client.doAPI(ctx, http.MethodGet, path, http.NoBody)
+// fetchActivities retrieves an activity list and keeps the raw JSON of each activity.
+func fetchActivities(ctx context.Context, c *Client, path string) ([]Activity, error) {
+	resp, err := c.doAPI(ctx, http.MethodGet, path, http.NoBody)
 	if err != nil {
 		return nil, err
 	}
@@ -1236,13 +1247,15 @@ func (s *ActivityService) GetSplitSummaries(ctx context.Context, activityID int6
 	return fetch[ActivitySplitSummaries](ctx, s.client, path)
 }
 
-// GearItem represents a piece of gear linked to an activity.
+// GearItem represents a piece of gear such as shoes or a bike.
 type GearItem struct {
 	GearPk          int64   `json:"gearPk"`
 	UUID            string  `json:"uuid"`
+	UserProfilePK   int64   `json:"userProfilePk"`
 	GearMakeName    string  `json:"gearMakeName"`
 	GearModelName   string  `json:"gearModelName"`
 	GearTypeName    string  `json:"gearTypeName"`
+	GearStatusName  string  `json:"gearStatusName"`
 	DisplayName     string  `json:"displayName"`
 	CustomMakeModel *string `json:"customMakeModel"`
 	ImageNameLarge  *string `json:"imageNameLarge"`
@@ -1254,6 +1267,18 @@ type GearItem struct {
 	Notified        *bool   `json:"notified"`
 	CreateDate      string  `json:"createDate"`
 	UpdateDate      string  `json:"updateDate"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (g *GearItem) RawJSON() json.RawMessage {
+	return g.raw
+}
+
+// SetRaw sets the raw JSON data.
+func (g *GearItem) SetRaw(data json.RawMessage) {
+	g.raw = data
 }
 
 // ActivityGear represents gear linked to an activity.
@@ -1282,3 +1307,197 @@ func (s *ActivityService) GetGear(ctx context.Context, activityID int64) (*Activ
 	path := fmt.Sprintf("/gear-service/gear/filterGear?activityId=%d", activityID)
 	return fetch[ActivityGear](ctx, s.client, path)
 }
+
+// ActivityPrivacy is the visibility of an activity.
+type ActivityPrivacy string
+
+// Activity privacy settings.
+const (
+	ActivityPrivacyPublic      ActivityPrivacy = "public"
+	ActivityPrivacyConnections ActivityPrivacy = "subscribers"
+	ActivityPrivacyGroups      ActivityPrivacy = "groups"
+	ActivityPrivacyPrivate     ActivityPrivacy = "private"
+)
+
+// activityPrivacyIDs maps privacy settings to their access control rule IDs.
+var activityPrivacyIDs = map[ActivityPrivacy]int{
+	ActivityPrivacyPublic:      1,
+	ActivityPrivacyPrivate:     2,
+	ActivityPrivacyConnections: 3,
+	ActivityPrivacyGroups:      4,
+}
+
+// accessControlRule returns the access control rule for the privacy setting.
+func (p ActivityPrivacy) accessControlRule() (*AccessControlRule, error) {
+	id, ok := activityPrivacyIDs[p]
+	if !ok {
+		return nil, fmt.Errorf("unknown activity privacy: %q", p)
+	}
+	return &AccessControlRule{TypeID: id, TypeKey: string(p)}, nil
+}
+
+// typeKeyRef references a Garmin type by its key.
+type typeKeyRef struct {
+	TypeKey string `json:"typeKey"`
+}
+
+// ManualActivity describes an activity entered manually rather than recorded by a device.
+type ManualActivity struct {
+	Name        string
+	TypeKey     string    // activity type, e.g. "running" (see GetActivityTypes)
+	Start       time.Time // wall-clock start time in TimeZone
+	TimeZone    string    // IANA time zone; defaults to the profile time zone
+	Duration    time.Duration
+	Distance    float64 // meters, optional
+	Description string
+	Privacy     ActivityPrivacy // defaults to the user's default privacy
+}
+
+// Validate checks that the required fields of the activity are set.
+func (m *ManualActivity) Validate() error {
+	switch {
+	case strings.TrimSpace(m.Name) == "":
+		return errors.New("activity name is required")
+	case m.TypeKey == "":
+		return errors.New("activity type is required")
+	case m.Start.IsZero():
+		return errors.New("activity start time is required")
+	case m.Duration <= 0:
+		return errors.New("activity duration must be positive")
+	case m.Distance < 0:
+		return errors.New("activity distance cannot be negative")
+	}
+	return nil
+}
+
+// manualActivityRequest is the request body for creating a manual activity.
+type manualActivityRequest struct {
+	ActivityName         string             `json:"activityName"`
+	Description          string             `json:"description,omitempty"`
+	ActivityTypeDTO      *ActivityType      `json:"activityTypeDTO"`
+	AccessControlRuleDTO *AccessControlRule `json:"accessControlRuleDTO,omitempty"`
+	TimeZoneUnitDTO      struct {
+		UnitKey string `json:"unitKey"`
+	} `json:"timeZoneUnitDTO"`
+	MetadataDTO struct {
+		AutoCalcCalories bool `json:"autoCalcCalories"`
+	} `json:"metadataDTO"`
+	SummaryDTO struct {
+		StartTimeLocal string  `json:"startTimeLocal"`
+		Duration       float64 `json:"duration"`           // seconds
+		Distance       float64 `json:"distance,omitempty"` // meters
+	} `json:"summaryDTO"`
+}
+
+// ActivityUpdate describes changes to an activity. Only non-nil fields are updated.
+type ActivityUpdate struct {
+	Name        *string
+	Description *string
+	TypeKey     *string // activity type, e.g. "running" (see GetActivityTypes)
+	Privacy     *ActivityPrivacy
+	EventType   *string // event type key, e.g. "race", "training" or "uncategorized"
+}
+
+// IsEmpty returns true if the update does not change any field.
+func (u *ActivityUpdate) IsEmpty() bool {
+	return u.Name == nil && u.Description == nil && u.TypeKey == nil && u.Privacy == nil && u.EventType == nil
+}
+
+// activityUpdateRequest is the request body for updating an activity.
+type activityUpdateRequest struct {
+	ActivityID           int64              `json:"activityId"`
+	ActivityName         *string            `json:"activityName,omitempty"`
+	Description          *string            `json:"description,omitempty"`
+	ActivityTypeDTO      *ActivityType      `json:"activityTypeDTO,omitempty"`
+	AccessControlRuleDTO *AccessControlRule `json:"accessControlRuleDTO,omitempty"`
+	EventTypeDTO         *typeKeyRef        `json:"eventTypeDTO,omitempty"`
+}
+
+// Create creates a manual activity and returns it.
+func (s *ActivityService) Create(ctx context.Context, activity *ManualActivity) (*ActivityDetail, error) {
+	if err := activity.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := &manualActivityRequest{
+		ActivityName: activity.Name,
+		Description:  activity.Description,
+	}
+	var err error
+	if req.ActivityTypeDTO, err = s.activityType(ctx, activity.TypeKey); err != nil {
+		return nil, err
+	}
+	if activity.Privacy != "" {
+		if req.AccessControlRuleDTO, err = activity.Privacy.accessControlRule(); err != nil {
+			return nil, err
+		}
+	}
+	req.TimeZoneUnitDTO.UnitKey = activity.TimeZone
+	if req.TimeZoneUnitDTO.UnitKey == "" {
+		settings, err := s.client.UserProfile.GetProfileSettings(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get profile time zone: %w", err)
+		}
+		req.TimeZoneUnitDTO.UnitKey = settings.TimeZone
+	}
+	req.MetadataDTO.AutoCalcCalories = true
+	req.SummaryDTO.StartTimeLocal = activity.Start.Format("2006-01-02T15:04:05.000")
+	req.SummaryDTO.Duration = activity.Duration.Seconds()
+	req.SummaryDTO.Distance = activity.Distance
+
+	return send[ActivityDetail](ctx, s.client, http.MethodPost, "/activity-service/activity", req)
+}
+
+// Update updates the fields of an activity that are set in update.
+func (s *ActivityService) Update(ctx context.Context, activityID int64, update *ActivityUpdate) error {
+	if update.IsEmpty() {
+		return errors.New("no activity fields to update")
+	}
+	if update.Name != nil && strings.TrimSpace(*update.Name) == "" {
+		return errors.New("activity name cannot be empty")
+	}
+
+	req := &activityUpdateRequest{
+		ActivityID:   activityID,
+		ActivityName: update.Name,
+		Description:  update.Description,
+	}
+	var err error
+	if update.TypeKey != nil {
+		if req.ActivityTypeDTO, err = s.activityType(ctx, *update.TypeKey); err != nil {
+			return err
+		}
+	}
+	if update.Privacy != nil {
+		if req.AccessControlRuleDTO, err = update.Privacy.accessControlRule(); err != nil {
+			return err
+		}
+	}
+	if update.EventType != nil {
+		req.EventTypeDTO = &typeKeyRef{TypeKey: *update.EventType}
+	}
+
+	path := fmt.Sprintf("/activity-service/activity/%d", activityID)
+	_, err = send[ignoredResponse](ctx, s.client, http.MethodPut, path, req)
+	return err
+}
+
+// Delete deletes an activity.
+func (s *ActivityService) Delete(ctx context.Context, activityID int64) error {
+	path := fmt.Sprintf("/activity-service/activity/%d", activityID)
+	return sendEmpty(ctx, s.client, http.MethodDelete, path)
+}
+
+// activityType looks up an activity type by its key.
+func (s *ActivityService) activityType(ctx context.Context, typeKey string) (*ActivityType, error) {
+	types, err := s.GetActivityTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get activity types: %w", err)
+	}
+	for i := range types {
+		if types[i].TypeKey == typeKey {
+			return &types[i], nil
+		}
+	}
+	return nil, fmt.Errorf("unknown activity type: %q", typeKey)
+}
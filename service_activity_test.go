@@ -1,7 +1,10 @@
 package garmin
 
 import (
+	"context"
 	"encoding/json"
+	"io"
+	"net/http"
 	"testing"
 	"time"
 )
@@ -791,3 +794,141 @@ func TestExerciseSetsRawJSON(t *testing.T) {
 		t.Error("RawJSON should return original JSON")
 	}
 }
+
+// activityTypesJSON is a minimal activity types response for write tests.
+const activityTypesJSON = `[
+	{"typeId": 1, "typeKey": "running", "parentTypeId": 17},
+	{"typeId": 13, "typeKey": "strength_training", "parentTypeId": 29}
+]`
+
+func TestActivityCreate(t *testing.T) {
+	var body map[string]any
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		switch {
+		case req.URL.Path == "/activity-service/activity/activityTypes":
+			return http.StatusOK, []byte(activityTypesJSON)
+		case req.Method == http.MethodPost && req.URL.Path == "/activity-service/activity":
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("decode request body: %v", err)
+			}
+			return http.StatusOK, []byte(`{"activityId": 123, "activityName": "Gym"}`)
+		default:
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+			return http.StatusNotFound, nil
+		}
+	})
+
+	activity, err := client.Activities.Create(context.Background(), &ManualActivity{
+		Name:     "Gym",
+		TypeKey:  "strength_training",
+		Start:    time.Date(2026, 1, 27, 18, 30, 0, 0, time.UTC),
+		TimeZone: "Europe/Paris",
+		Duration: 45 * time.Minute,
+		Privacy:  ActivityPrivacyPrivate,
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if activity.ActivityID != 123 {
+		t.Errorf("ActivityID = %d, want 123", activity.ActivityID)
+	}
+
+	want := `{"accessControlRuleDTO":{"typeId":2,"typeKey":"private"},"activityName":"Gym",` +
+		`"activityTypeDTO":{"isHidden":false,"parentTypeId":29,"restricted":false,"trimmable":false,"typeId":13,"typeKey":"strength_training"},` +
+		`"metadataDTO":{"autoCalcCalories":true},"summaryDTO":{"duration":2700,"startTimeLocal":"2026-01-27T18:30:00.000"},` +
+		`"timeZoneUnitDTO":{"unitKey":"Europe/Paris"}}`
+	if got, _ := json.Marshal(body); string(got) != want {
+		t.Errorf("request body = %s, want %s", got, want)
+	}
+}
+
+func TestManualActivityValidate(t *testing.T) {
+	valid := ManualActivity{Name: "Run", TypeKey: "running", Start: time.Now(), Duration: time.Hour}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*ManualActivity)
+	}{
+		{"missing name", func(m *ManualActivity) { m.Name = " " }},
+		{"missing type", func(m *ManualActivity) { m.TypeKey = "" }},
+		{"missing start", func(m *ManualActivity) { m.Start = time.Time{} }},
+		{"zero duration", func(m *ManualActivity) { m.Duration = 0 }},
+		{"negative distance", func(m *ManualActivity) { m.Distance = -1 }},
+	}
+	for _, tt := range tests {
+		m := valid
+		tt.modify(&m)
+		if err := m.Validate(); err == nil {
+			t.Errorf("%s: expected validation error", tt.name)
+		}
+	}
+}
+
+func TestActivityUpdateSendsOnlySetFields(t *testing.T) {
+	var body string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.Method != http.MethodPut || req.URL.Path != "/activity-service/activity/42" {
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		raw, _ := io.ReadAll(req.Body)
+		body = string(raw)
+		return http.StatusNoContent, nil
+	})
+
+	name := "Morning run"
+	privacy := ActivityPrivacyPublic
+	err := client.Activities.Update(context.Background(), 42, &ActivityUpdate{Name: &name, Privacy: &privacy})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	want := `{"activityId":42,"activityName":"Morning run","accessControlRuleDTO":{"typeId":1,"typeKey":"public"}}`
+	if body != want {
+		t.Errorf("request body = %s, want %s", body, want)
+	}
+}
+
+func TestActivityUpdateValidation(t *testing.T) {
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.URL.Path == "/activity-service/activity/activityTypes" {
+			return http.StatusOK, []byte(activityTypesJSON)
+		}
+		t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		return http.StatusNoContent, nil
+	})
+	ctx := context.Background()
+
+	if err := client.Activities.Update(ctx, 42, &ActivityUpdate{}); err == nil {
+		t.Error("expected error for empty update")
+	}
+	empty := ""
+	if err := client.Activities.Update(ctx, 42, &ActivityUpdate{Name: &empty}); err == nil {
+		t.Error("expected error for empty name")
+	}
+	unknownType := "underwater_basket_weaving"
+	if err := client.Activities.Update(ctx, 42, &ActivityUpdate{TypeKey: &unknownType}); err == nil {
+		t.Error("expected error for unknown activity type")
+	}
+	unknownPrivacy := ActivityPrivacy("everyone")
+	if err := client.Activities.Update(ctx, 42, &ActivityUpdate{Privacy: &unknownPrivacy}); err == nil {
+		t.Error("expected error for unknown privacy")
+	}
+}
+
+func TestActivityDelete(t *testing.T) {
+	var method, path string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		method, path = req.Method, req.URL.Path
+		return http.StatusNoContent, nil
+	})
+
+	if err := client.Activities.Delete(context.Background(), 42); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if method != http.MethodDelete || path != "/activity-service/activity/42" {
+		t.Errorf("request = %s %s, want DELETE /activity-service/activity/42", method, path)
+	}
+}
@@ -0,0 +1,219 @@
+// service_badge.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// badgePageSize is the page size used when iterating over challenges.
+const badgePageSize = 50
+
+// Badge represents an earned or available badge.
+type Badge struct {
+	BadgeID                int64    `json:"badgeId"`
+	BadgeKey               string   `json:"badgeKey"`
+	BadgeName              string   `json:"badgeName"`
+	BadgeUUID              *string  `json:"badgeUuid"`
+	BadgeCategoryID        int      `json:"badgeCategoryId"`
+	BadgeDifficultyID      int      `json:"badgeDifficultyId"`
+	BadgePoints            int      `json:"badgePoints"`
+	BadgeTypeIDs           []int    `json:"badgeTypeIds"`
+	BadgeSeriesID          *int     `json:"badgeSeriesId"`
+	BadgeStartDate         string   `json:"badgeStartDate"`
+	BadgeEndDate           *string  `json:"badgeEndDate"`
+	BadgeEarnedDate        *string  `json:"badgeEarnedDate"`
+	BadgeEarnedNumber      int      `json:"badgeEarnedNumber"`
+	BadgeLimitCount        *int     `json:"badgeLimitCount"`
+	BadgeIsViewed          bool     `json:"badgeIsViewed"`
+	BadgeProgressValue     *float64 `json:"badgeProgressValue"`
+	BadgeTargetValue       *float64 `json:"badgeTargetValue"`
+	BadgeUnitID            *int     `json:"badgeUnitId"`
+	BadgeAssocTypeID       *int     `json:"badgeAssocTypeId"`
+	BadgeAssocDataID       *string  `json:"badgeAssocDataId"`
+	BadgeAssocDataName     *string  `json:"badgeAssocDataName"`
+	EarnedByMe             bool     `json:"earnedByMe"`
+	UserJoined             *bool    `json:"userJoined"`
+	BadgeChallengeStatusID *int     `json:"badgeChallengeStatusId"`
+	CreateDate             *string  `json:"createDate"`
+}
+
+// Earned returns true if the badge has been earned at least once.
+func (b *Badge) Earned() bool {
+	return b.BadgeEarnedDate != nil || b.BadgeEarnedNumber > 0
+}
+
+// BadgeList represents a list of badges.
+type BadgeList struct {
+	Items []Badge
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (b *BadgeList) RawJSON() json.RawMessage {
+	return b.raw
+}
+
+// SetRaw sets the raw JSON response.
+func (b *BadgeList) SetRaw(data json.RawMessage) {
+	b.raw = data
+}
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (b *BadgeList) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &b.Items)
+}
+
+// TotalPoints returns the sum of badge points for earned badges.
+func (b *BadgeList) TotalPoints() int {
+	total := 0
+	for i := range b.Items {
+		if b.Items[i].Earned() {
+			total += b.Items[i].BadgePoints * max(1, b.Items[i].BadgeEarnedNumber)
+		}
+	}
+	return total
+}
+
+// BadgeChallenge represents a badge challenge or virtual challenge.
+type BadgeChallenge struct {
+	UUID                   string   `json:"uuid"`
+	BadgeChallengeName     string   `json:"badgeChallengeName"`
+	ChallengeCategoryID    int      `json:"challengeCategoryId"`
+	BadgeChallengeStatusID int      `json:"badgeChallengeStatusId"`
+	StartDate              string   `json:"startDate"`
+	EndDate                string   `json:"endDate"`
+	CreateDate             *string  `json:"createDate"`
+	UpdateDate             *string  `json:"updateDate"`
+	BadgeID                int64    `json:"badgeId"`
+	BadgeKey               string   `json:"badgeKey"`
+	BadgeUUID              *string  `json:"badgeUuid"`
+	BadgePoints            int      `json:"badgePoints"`
+	BadgeUnitID            *int     `json:"badgeUnitId"`
+	BadgeProgressValue     *float64 `json:"badgeProgressValue"`
+	BadgeTargetValue       *float64 `json:"badgeTargetValue"`
+	BadgeEarnedDate        *string  `json:"badgeEarnedDate"`
+	BadgeTypeIDs           []int    `json:"badgeTypeIds"`
+	UserJoined             bool     `json:"userJoined"`
+}
+
+// Progress returns the challenge completion as a percentage (0-100),
+// or 0 if the challenge has no target.
+func (c *BadgeChallenge) Progress() float64 {
+	if c.BadgeProgressValue == nil || c.BadgeTargetValue == nil || *c.BadgeTargetValue == 0 {
+		return 0
+	}
+	return clampPercent(*c.BadgeProgressValue / *c.BadgeTargetValue * 100)
+}
+
+// BadgeChallengeList represents a page of badge challenges.
+type BadgeChallengeList struct {
+	Items []BadgeChallenge
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (b *BadgeChallengeList) RawJSON() json.RawMessage {
+	return b.raw
+}
+
+// SetRaw sets the raw JSON response.
+func (b *BadgeChallengeList) SetRaw(data json.RawMessage) {
+	b.raw = data
+}
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (b *BadgeChallengeList) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &b.Items)
+}
+
+// AdHocChallenge represents a challenge between connections.
+type AdHocChallenge struct {
+	UUID                          string  `json:"uuid"`
+	AdHocChallengeName            string  `json:"adHocChallengeName"`
+	AdHocChallengeDesc            *string `json:"adHocChallengeDesc"`
+	SocialChallengeStatusID       int     `json:"socialChallengeStatusId"`
+	SocialChallengeActivityTypeID int     `json:"socialChallengeActivityTypeId"`
+	SocialChallengeType           int     `json:"socialChallengeType"`
+	StartDate                     string  `json:"startDate"`
+	EndDate                       string  `json:"endDate"`
+	UserRanking                   *int    `json:"userRanking"`
+	PlayersCount                  int     `json:"playerCount"`
+}
+
+// AdHocChallengeList represents a page of ad-hoc challenges.
+type AdHocChallengeList struct {
+	Items []AdHocChallenge
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (a *AdHocChallengeList) RawJSON() json.RawMessage {
+	return a.raw
+}
+
+// SetRaw sets the raw JSON response.
+func (a *AdHocChallengeList) SetRaw(data json.RawMessage) {
+	a.raw = data
+}
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (a *AdHocChallengeList) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &a.Items)
+}
+
+// GetEarned retrieves the badges earned by the user.
+func (s *BadgeService) GetEarned(ctx context.Context) (*BadgeList, error) {
+	return fetch[BadgeList](ctx, s.client, "/badge-service/badge/earned")
+}
+
+// GetAvailable retrieves the badges available to the user.
+func (s *BadgeService) GetAvailable(ctx context.Context) (*BadgeList, error) {
+	return fetch[BadgeList](ctx, s.client, "/badge-service/badge/available?showExclusiveBadge=true")
+}
+
+// CompletedChallenges iterates over completed badge challenges.
+func (s *BadgeService) CompletedChallenges(ctx context.Context) iter.Seq2[BadgeChallenge, error] {
+	return s.challenges(ctx, "/badgechallenge-service/badgeChallenge/completed")
+}
+
+// AvailableChallenges iterates over badge challenges the user can join.
+func (s *BadgeService) AvailableChallenges(ctx context.Context) iter.Seq2[BadgeChallenge, error] {
+	return s.challenges(ctx, "/badgechallenge-service/badgeChallenge/available")
+}
+
+// NonCompletedChallenges iterates over joined badge challenges that are not completed yet.
+func (s *BadgeService) NonCompletedChallenges(ctx context.Context) iter.Seq2[BadgeChallenge, error] {
+	return s.challenges(ctx, "/badgechallenge-service/badgeChallenge/non-completed")
+}
+
+// InProgressVirtualChallenges iterates over virtual challenges in progress.
+func (s *BadgeService) InProgressVirtualChallenges(ctx context.Context) iter.Seq2[BadgeChallenge, error] {
+	return s.challenges(ctx, "/badgechallenge-service/virtualChallenge/inProgress")
+}
+
+// HistoricalAdHocChallenges iterates over past ad-hoc challenges with connections.
+func (s *BadgeService) HistoricalAdHocChallenges(ctx context.Context) iter.Seq2[AdHocChallenge, error] {
+	return paginate(ctx, 1, badgePageSize, func(ctx context.Context, start, limit int) ([]AdHocChallenge, error) {
+		path := fmt.Sprintf("/adhocchallenge-service/adHocChallenge/historical?start=%d&limit=%d", start, limit)
+		list, err := fetch[AdHocChallengeList](ctx, s.client, path)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+// challenges iterates over a paginated badge challenge endpoint (1-based start index).
+func (s *BadgeService) challenges(ctx context.Context, basePath string) iter.Seq2[BadgeChallenge, error] {
+	return paginate(ctx, 1, badgePageSize, func(ctx context.Context, start, limit int) ([]BadgeChallenge, error) {
+		path := fmt.Sprintf("%s?start=%d&limit=%d", basePath, start, limit)
+		list, err := fetch[BadgeChallengeList](ctx, s.client, path)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
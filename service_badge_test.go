@@ -0,0 +1,181 @@
+// service_badge_test.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestBadgeListJSONUnmarshal(t *testing.T) {
+	rawJSON := `[
+		{
+			"badgeId": 2,
+			"badgeKey": "challenge_steps_30_day",
+			"badgeName": "30-Day Step Streak",
+			"badgeUuid": null,
+			"badgeCategoryId": 4,
+			"badgeDifficultyId": 2,
+			"badgePoints": 4,
+			"badgeTypeIds": [1, 2],
+			"badgeSeriesId": null,
+			"badgeStartDate": "2015-01-01T00:00:00.0",
+			"badgeEndDate": null,
+			"badgeEarnedDate": "2025-11-02T06:00:00.0",
+			"badgeEarnedNumber": 2,
+			"badgeLimitCount": null,
+			"badgeIsViewed": true,
+			"badgeProgressValue": null,
+			"badgeTargetValue": null,
+			"earnedByMe": true
+		},
+		{
+			"badgeId": 17,
+			"badgeKey": "marathon",
+			"badgeName": "Marathon",
+			"badgePoints": 8,
+			"badgeEarnedDate": null,
+			"badgeEarnedNumber": 0,
+			"badgeProgressValue": 21097.5,
+			"badgeTargetValue": 42195.0,
+			"earnedByMe": false
+		}
+	]`
+
+	var badges BadgeList
+	if err := json.Unmarshal([]byte(rawJSON), &badges); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if len(badges.Items) != 2 {
+		t.Fatalf("len(Items) = %d, want 2", len(badges.Items))
+	}
+	if badges.Items[0].BadgeKey != "challenge_steps_30_day" {
+		t.Errorf("BadgeKey = %s, want challenge_steps_30_day", badges.Items[0].BadgeKey)
+	}
+	if !badges.Items[0].Earned() {
+		t.Error("expected first badge to be earned")
+	}
+	if badges.Items[1].Earned() {
+		t.Error("expected second badge not to be earned")
+	}
+	if got := badges.TotalPoints(); got != 8 {
+		t.Errorf("TotalPoints() = %d, want 8", got)
+	}
+}
+
+func TestBadgeChallengeProgress(t *testing.T) {
+	rawJSON := `[
+		{
+			"uuid": "8E5A3C1B2D4F4A6B9C0D1E2F3A4B5C6D",
+			"badgeChallengeName": "January Step Challenge",
+			"challengeCategoryId": 3,
+			"badgeChallengeStatusId": 2,
+			"startDate": "2026-01-01T00:00:00.0",
+			"endDate": "2026-01-31T23:59:59.0",
+			"badgeId": 1234,
+			"badgeKey": "challenge_january_steps_2026",
+			"badgePoints": 2,
+			"badgeProgressValue": 150000,
+			"badgeTargetValue": 200000,
+			"userJoined": true
+		}
+	]`
+
+	var challenges BadgeChallengeList
+	if err := json.Unmarshal([]byte(rawJSON), &challenges); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if len(challenges.Items) != 1 {
+		t.Fatalf("len(Items) = %d, want 1", len(challenges.Items))
+	}
+	c := challenges.Items[0]
+	if !c.UserJoined {
+		t.Error("expected UserJoined to be true")
+	}
+	if math.Abs(c.Progress()-75) > 0.001 {
+		t.Errorf("Progress() = %f, want 75", c.Progress())
+	}
+
+	var empty BadgeChallenge
+	if empty.Progress() != 0 {
+		t.Errorf("Progress() = %f, want 0 for challenge without target", empty.Progress())
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5, 6, 7}
+	var starts []int
+
+	seq := paginate(context.Background(), 1, 3, func(_ context.Context, start, limit int) ([]int, error) {
+		starts = append(starts, start)
+		from := start - 1
+		to := min(from+limit, len(data))
+		if from >= len(data) {
+			return nil, nil
+		}
+		return data[from:to], nil
+	})
+
+	var got []int
+	for v, err := range seq {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, v)
+	}
+
+	if len(got) != len(data) {
+		t.Errorf("got %v, want %v", got, data)
+	}
+	if len(starts) != 3 || starts[0] != 1 || starts[1] != 4 || starts[2] != 7 {
+		t.Errorf("page starts = %v, want [1 4 7]", starts)
+	}
+}
+
+func TestPaginateStopsEarly(t *testing.T) {
+	calls := 0
+	seq := paginate(context.Background(), 0, 2, func(_ context.Context, start, _ int) ([]int, error) {
+		calls++
+		return []int{start, start + 1}, nil
+	})
+
+	for v, err := range seq {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v >= 2 {
+			break
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("fetchPage called %d times, want 2", calls)
+	}
+}
+
+func TestPaginateErrors(t *testing.T) {
+	apiErr := &APIError{StatusCode: 500, Status: "500 Internal Server Error"}
+	seq := paginate(context.Background(), 1, 2, func(context.Context, int, int) ([]int, error) {
+		return nil, apiErr
+	})
+
+	var gotErr error
+	for _, err := range seq {
+		gotErr = err
+	}
+	if !errors.Is(gotErr, apiErr) {
+		t.Errorf("error = %v, want %v", gotErr, apiErr)
+	}
+
+	// ErrNotFound (204 No Content) ends iteration without error
+	seq = paginate(context.Background(), 1, 2, func(context.Context, int, int) ([]int, error) {
+		return nil, ErrNotFound
+	})
+	for _, err := range seq {
+		t.Errorf("unexpected iteration with error %v", err)
+	}
+}
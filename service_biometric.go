@@ -4,6 +4,7 @@ package garmin
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -129,6 +130,64 @@ type HeartRateZone struct {
 	ChangeState                   string `json:"changeState"`
 }
 
+// Training methods of heart rate zones.
+const (
+	HeartRateZoneMethodMax     = "HR_MAX"
+	HeartRateZoneMethodReserve = "HR_RESERVE"
+)
+
+// Bounds of the heart rates and FTP accepted when writing thresholds and zones.
+const (
+	minHeartRateBPM = 30
+	maxHeartRateBPM = 250
+	maxFTPWatts     = 1000
+)
+
+// Floors returns the lower bound of each of the five zones, in bpm.
+func (z *HeartRateZone) Floors() [5]int {
+	return [5]int{z.Zone1Floor, z.Zone2Floor, z.Zone3Floor, z.Zone4Floor, z.Zone5Floor}
+}
+
+// Validate checks that the zones are strictly increasing and cover the heart rate
+// range from the zone 1 floor up to the max heart rate.
+func (z *HeartRateZone) Validate() error {
+	if z.Sport == "" {
+		return errors.New("heart rate zones sport is required")
+	}
+	if z.TrainingMethod == "" {
+		return fmt.Errorf("heart rate zones for %s: training method is required", z.Sport)
+	}
+	if z.MaxHeartRateUsed < minHeartRateBPM || z.MaxHeartRateUsed > maxHeartRateBPM {
+		return fmt.Errorf("heart rate zones for %s: max heart rate must be between %d and %d bpm, got %d",
+			z.Sport, minHeartRateBPM, maxHeartRateBPM, z.MaxHeartRateUsed)
+	}
+	floors := z.Floors()
+	if floors[0] < minHeartRateBPM {
+		return fmt.Errorf("heart rate zones for %s: zone 1 floor must be at least %d bpm, got %d", z.Sport, minHeartRateBPM, floors[0])
+	}
+	for i := 1; i < len(floors); i++ {
+		if floors[i] <= floors[i-1] {
+			return fmt.Errorf("heart rate zones for %s: zone %d floor (%d bpm) must be above zone %d floor (%d bpm)",
+				z.Sport, i+1, floors[i], i, floors[i-1])
+		}
+	}
+	if floors[4] >= z.MaxHeartRateUsed {
+		return fmt.Errorf("heart rate zones for %s: zone 5 floor (%d bpm) must be below the max heart rate (%d bpm)",
+			z.Sport, floors[4], z.MaxHeartRateUsed)
+	}
+	if z.TrainingMethod == HeartRateZoneMethodReserve {
+		if z.RestingHeartRateUsed <= 0 || z.RestingHeartRateUsed >= floors[0] {
+			return fmt.Errorf("heart rate zones for %s: resting heart rate must be set and below the zone 1 floor (%d bpm), got %d",
+				z.Sport, floors[0], z.RestingHeartRateUsed)
+		}
+	}
+	if z.LactateThresholdHeartRateUsed != 0 && z.LactateThresholdHeartRateUsed >= z.MaxHeartRateUsed {
+		return fmt.Errorf("heart rate zones for %s: lactate threshold heart rate (%d bpm) must be below the max heart rate (%d bpm)",
+			z.Sport, z.LactateThresholdHeartRateUsed, z.MaxHeartRateUsed)
+	}
+	return nil
+}
+
 // HeartRateZones represents a collection of heart rate zone configurations.
 type HeartRateZones struct {
 	Zones []HeartRateZone
@@ -251,3 +310,60 @@ func (s *BiometricService) GetFTPRange(ctx context.Context, start, end time.Time
 func (s *BiometricService) getBiometricStats(ctx context.Context, path string) (*BiometricStats, error) {
 	return fetch[BiometricStats](ctx, s.client, path)
 }
+
+// SetHeartRateZones replaces the heart rate zones of the given sports. Zones of
+// sports not given are left unchanged.
+func (s *BiometricService) SetHeartRateZones(ctx context.Context, zones ...HeartRateZone) error {
+	if len(zones) == 0 {
+		return errors.New("no heart rate zones to set")
+	}
+	req := make([]HeartRateZone, len(zones))
+	seen := make(map[string]bool, len(zones))
+	for i, z := range zones {
+		if err := z.Validate(); err != nil {
+			return err
+		}
+		if seen[z.Sport] {
+			return fmt.Errorf("heart rate zones for %s given more than once", z.Sport)
+		}
+		seen[z.Sport] = true
+		z.ChangeState = "CHANGED"
+		req[i] = z
+	}
+	_, err := send[ignoredResponse](ctx, s.client, http.MethodPut, "/biometric-service/heartRateZones", req)
+	return err
+}
+
+// SetFTP sets the cycling Functional Threshold Power, in watts, and turns off its
+// automatic detection.
+func (s *BiometricService) SetFTP(ctx context.Context, watts int) error {
+	if watts <= 0 || watts > maxFTPWatts {
+		return fmt.Errorf("FTP must be between 1 and %d W, got %d", maxFTPWatts, watts)
+	}
+	autoDetected := false
+	return s.client.UserProfile.updateUserSettings(ctx, &userSettingsRequest{UserData: &userDataUpdate{
+		FunctionalThresholdPower: &watts,
+		FTPAutoDetected:          &autoDetected,
+	}})
+}
+
+// SetLactateThreshold sets the lactate threshold heart rate, in bpm, and turns off
+// its automatic detection. The speed is in the unit of LactateThreshold.Speed and
+// is left unchanged if zero.
+func (s *BiometricService) SetLactateThreshold(ctx context.Context, heartRate int, speed float64) error {
+	if heartRate < minHeartRateBPM || heartRate > maxHeartRateBPM {
+		return fmt.Errorf("lactate threshold heart rate must be between %d and %d bpm, got %d", minHeartRateBPM, maxHeartRateBPM, heartRate)
+	}
+	if speed < 0 {
+		return fmt.Errorf("lactate threshold speed cannot be negative, got %v", speed)
+	}
+	autoDetected := false
+	data := &userDataUpdate{
+		LactateThresholdHeartRate:      &heartRate,
+		ThresholdHeartRateAutoDetected: &autoDetected,
+	}
+	if speed > 0 {
+		data.LactateThresholdSpeed = &speed
+	}
+	return s.client.UserProfile.updateUserSettings(ctx, &userSettingsRequest{UserData: data})
+}
@@ -1,7 +1,9 @@
 package garmin
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 )
 
@@ -221,3 +223,129 @@ func TestHeartRateZonesRawJSON(t *testing.T) {
 		t.Error("RawJSON should return original JSON")
 	}
 }
+
+func TestHeartRateZoneValidate(t *testing.T) {
+	valid := HeartRateZone{
+		TrainingMethod:       HeartRateZoneMethodReserve,
+		RestingHeartRateUsed: 48,
+		Zone1Floor:           115,
+		Zone2Floor:           129,
+		Zone3Floor:           143,
+		Zone4Floor:           156,
+		Zone5Floor:           170,
+		MaxHeartRateUsed:     184,
+		Sport:                "RUNNING",
+	}
+	tests := []struct {
+		name    string
+		modify  func(z *HeartRateZone)
+		wantErr bool
+	}{
+		{"valid", func(*HeartRateZone) {}, false},
+		{"missing sport", func(z *HeartRateZone) { z.Sport = "" }, true},
+		{"missing method", func(z *HeartRateZone) { z.TrainingMethod = "" }, true},
+		{"not increasing", func(z *HeartRateZone) { z.Zone3Floor = z.Zone2Floor }, true},
+		{"zone 5 above max", func(z *HeartRateZone) { z.MaxHeartRateUsed = 170 }, true},
+		{"max out of range", func(z *HeartRateZone) { z.MaxHeartRateUsed = 300 }, true},
+		{"zone 1 too low", func(z *HeartRateZone) { z.Zone1Floor = 0 }, true},
+		{"reserve without resting", func(z *HeartRateZone) { z.RestingHeartRateUsed = 0 }, true},
+		{"max method without resting", func(z *HeartRateZone) {
+			z.TrainingMethod = HeartRateZoneMethodMax
+			z.RestingHeartRateUsed = 0
+		}, false},
+		{"lactate threshold above max", func(z *HeartRateZone) { z.LactateThresholdHeartRateUsed = 190 }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z := valid
+			tt.modify(&z)
+			err := z.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetHeartRateZones(t *testing.T) {
+	var sent []HeartRateZone
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.Method != http.MethodPut || req.URL.Path != "/biometric-service/heartRateZones" {
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		return http.StatusNoContent, nil
+	})
+	ctx := context.Background()
+
+	zone := HeartRateZone{
+		TrainingMethod:   HeartRateZoneMethodMax,
+		Zone1Floor:       95,
+		Zone2Floor:       114,
+		Zone3Floor:       133,
+		Zone4Floor:       152,
+		Zone5Floor:       171,
+		MaxHeartRateUsed: 190,
+		Sport:            "CYCLING",
+		ChangeState:      "UNCHANGED",
+	}
+	if err := client.Biometric.SetHeartRateZones(ctx, zone); err != nil {
+		t.Fatalf("SetHeartRateZones failed: %v", err)
+	}
+	if len(sent) != 1 || sent[0].Sport != "CYCLING" || sent[0].Zone5Floor != 171 {
+		t.Fatalf("sent = %+v, want the cycling zones", sent)
+	}
+	if sent[0].ChangeState != "CHANGED" {
+		t.Errorf("ChangeState = %q, want CHANGED", sent[0].ChangeState)
+	}
+
+	if err := client.Biometric.SetHeartRateZones(ctx, zone, zone); err == nil {
+		t.Error("expected error for a sport given twice")
+	}
+	if err := client.Biometric.SetHeartRateZones(ctx); err == nil {
+		t.Error("expected error for no zones")
+	}
+}
+
+func TestSetThresholds(t *testing.T) {
+	var sent struct {
+		UserData map[string]any `json:"userData"`
+	}
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.Method != http.MethodPut || req.URL.Path != "/userprofile-service/userprofile/user-settings" {
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		sent.UserData = nil
+		if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		return http.StatusNoContent, nil
+	})
+	ctx := context.Background()
+
+	if err := client.Biometric.SetFTP(ctx, 265); err != nil {
+		t.Fatalf("SetFTP failed: %v", err)
+	}
+	if sent.UserData["functionalThresholdPower"] != float64(265) || sent.UserData["ftpAutoDetected"] != false {
+		t.Errorf("userData = %v, want FTP 265 not auto detected", sent.UserData)
+	}
+
+	if err := client.Biometric.SetLactateThreshold(ctx, 168, 0); err != nil {
+		t.Fatalf("SetLactateThreshold failed: %v", err)
+	}
+	if sent.UserData["lactateThresholdHeartRate"] != float64(168) || sent.UserData["thresholdHeartRateAutoDetected"] != false {
+		t.Errorf("userData = %v, want lactate threshold 168 bpm not auto detected", sent.UserData)
+	}
+	if _, ok := sent.UserData["lactateThresholdSpeed"]; ok {
+		t.Errorf("userData = %v, want speed left unchanged", sent.UserData)
+	}
+
+	if err := client.Biometric.SetFTP(ctx, 0); err == nil {
+		t.Error("expected error for a zero FTP")
+	}
+	if err := client.Biometric.SetLactateThreshold(ctx, 400, 0); err == nil {
+		t.Error("expected error for an implausible heart rate")
+	}
+}
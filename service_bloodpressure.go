@@ -0,0 +1,274 @@
+// service_bloodpressure.go
+package garmin
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BloodPressureCategory is the classification of a blood pressure reading
+// following the American Heart Association guidelines.
+type BloodPressureCategory string
+
+// Blood pressure categories.
+const (
+	BloodPressureNormal   BloodPressureCategory = "normal"
+	BloodPressureElevated BloodPressureCategory = "elevated"
+	BloodPressureStage1   BloodPressureCategory = "stage_1_hypertension"
+	BloodPressureStage2   BloodPressureCategory = "stage_2_hypertension"
+	BloodPressureCrisis   BloodPressureCategory = "hypertensive_crisis"
+)
+
+// bloodPressureTimestampFormat is the timestamp layout expected when logging readings.
+const bloodPressureTimestampFormat = "2006-01-02T15:04:05.00"
+
+// ClassifyBloodPressure returns the category of a systolic/diastolic reading in mmHg.
+func ClassifyBloodPressure(systolic, diastolic int) BloodPressureCategory {
+	switch {
+	case systolic > 180 || diastolic > 120:
+		return BloodPressureCrisis
+	case systolic >= 140 || diastolic >= 90:
+		return BloodPressureStage2
+	case systolic >= 130 || diastolic >= 80:
+		return BloodPressureStage1
+	case systolic >= 120:
+		return BloodPressureElevated
+	default:
+		return BloodPressureNormal
+	}
+}
+
+// BloodPressureMeasurement represents a single blood pressure reading.
+type BloodPressureMeasurement struct {
+	Version                   int64   `json:"version"`
+	Systolic                  int     `json:"systolic"`
+	Diastolic                 int     `json:"diastolic"`
+	Pulse                     int     `json:"pulse"`
+	MultiMeasurement          bool    `json:"multiMeasurement"`
+	SourceType                string  `json:"sourceType"`
+	MeasurementTimestampLocal string  `json:"measurementTimestampLocal"`
+	MeasurementTimestampGMT   string  `json:"measurementTimestampGMT"`
+	Notes                     *string `json:"notes"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (m *BloodPressureMeasurement) RawJSON() json.RawMessage { return m.raw }
+
+// SetRaw sets the raw JSON response.
+func (m *BloodPressureMeasurement) SetRaw(data json.RawMessage) { m.raw = data }
+
+// Category returns the classification of the reading.
+func (m *BloodPressureMeasurement) Category() BloodPressureCategory {
+	return ClassifyBloodPressure(m.Systolic, m.Diastolic)
+}
+
+// CalendarDate returns the local date of the reading (YYYY-MM-DD), as used by Delete.
+func (m *BloodPressureMeasurement) CalendarDate() string {
+	date, _, _ := strings.Cut(m.MeasurementTimestampLocal, "T")
+	return date
+}
+
+// BloodPressureSummary represents the readings and statistics for a day.
+type BloodPressureSummary struct {
+	StartDate         string                     `json:"startDate"`
+	EndDate           string                     `json:"endDate"`
+	HighSystolic      *int                       `json:"highSystolic"`
+	LowSystolic       *int                       `json:"lowSystolic"`
+	HighDiastolic     *int                       `json:"highDiastolic"`
+	LowDiastolic      *int                       `json:"lowDiastolic"`
+	NumOfMeasurements int                        `json:"numOfMeasurements"`
+	AverageSystolic   *float64                   `json:"averageSystolic"`
+	AverageDiastolic  *float64                   `json:"averageDiastolic"`
+	Measurements      []BloodPressureMeasurement `json:"measurements"`
+}
+
+// BloodPressureRange represents blood pressure readings for a date range.
+type BloodPressureRange struct {
+	From                 string                 `json:"from"`
+	Until                string                 `json:"until"`
+	MeasurementSummaries []BloodPressureSummary `json:"measurementSummaries"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (r *BloodPressureRange) RawJSON() json.RawMessage { return r.raw }
+
+// SetRaw sets the raw JSON response.
+func (r *BloodPressureRange) SetRaw(data json.RawMessage) { r.raw = data }
+
+// Measurements returns all readings of the range.
+func (r *BloodPressureRange) Measurements() []BloodPressureMeasurement {
+	var measurements []BloodPressureMeasurement
+	for i := range r.MeasurementSummaries {
+		measurements = append(measurements, r.MeasurementSummaries[i].Measurements...)
+	}
+	return measurements
+}
+
+// BloodPressureReading is a manual reading to log.
+type BloodPressureReading struct {
+	Systolic  int       `json:"systolic"`
+	Diastolic int       `json:"diastolic"`
+	Pulse     int       `json:"pulse"`
+	Timestamp time.Time `json:"timestamp"`
+	Notes     string    `json:"notes,omitempty"`
+}
+
+// Validate checks that the reading values are plausible.
+func (r *BloodPressureReading) Validate() error {
+	switch {
+	case r.Systolic <= 0 || r.Diastolic <= 0 || r.Pulse <= 0:
+		return errors.New("systolic, diastolic and pulse must be positive")
+	case r.Systolic <= r.Diastolic:
+		return fmt.Errorf("systolic (%d) must be greater than diastolic (%d)", r.Systolic, r.Diastolic)
+	case r.Timestamp.IsZero():
+		return errors.New("timestamp is required")
+	}
+	return nil
+}
+
+// bloodPressureRequest is the request body for logging a reading.
+type bloodPressureRequest struct {
+	MeasurementTimestampLocal string `json:"measurementTimestampLocal"`
+	MeasurementTimestampGMT   string `json:"measurementTimestampGMT"`
+	Systolic                  int    `json:"systolic"`
+	Diastolic                 int    `json:"diastolic"`
+	Pulse                     int    `json:"pulse"`
+	SourceType                string `json:"sourceType"`
+	Notes                     string `json:"notes"`
+}
+
+// bloodPressureCSVTimeFormats are the accepted timestamp layouts for manual readings.
+var bloodPressureCSVTimeFormats = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+}
+
+// ParseBloodPressureTimestamp parses a "YYYY-MM-DD HH:MM[:SS]" timestamp in the local time zone.
+func ParseBloodPressureTimestamp(s string) (time.Time, error) {
+	for _, layout := range bloodPressureCSVTimeFormats {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp: %q (expected YYYY-MM-DD HH:MM[:SS])", s)
+}
+
+// ParseBloodPressureCSV parses readings from CSV with a header row containing the
+// columns timestamp, systolic, diastolic, pulse and optionally notes (in any order).
+// Timestamps are "YYYY-MM-DD HH:MM[:SS]" in the local time zone.
+func ParseBloodPressureCSV(r io.Reader) ([]BloodPressureReading, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"timestamp", "systolic", "diastolic", "pulse"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing column: %s", name)
+		}
+	}
+
+	var readings []BloodPressureReading
+	for line := 2; ; line++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		reading, err := parseBloodPressureRecord(field)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		readings = append(readings, reading)
+	}
+	return readings, nil
+}
+
+// parseBloodPressureRecord parses a CSV record through its field accessor.
+func parseBloodPressureRecord(field func(name string) string) (BloodPressureReading, error) {
+	reading := BloodPressureReading{Notes: field("notes")}
+
+	var err error
+	values := []struct {
+		name string
+		dst  *int
+	}{
+		{"systolic", &reading.Systolic},
+		{"diastolic", &reading.Diastolic},
+		{"pulse", &reading.Pulse},
+	}
+	for _, v := range values {
+		if *v.dst, err = strconv.Atoi(field(v.name)); err != nil {
+			return reading, fmt.Errorf("invalid %s: %q", v.name, field(v.name))
+		}
+	}
+
+	if reading.Timestamp, err = ParseBloodPressureTimestamp(field("timestamp")); err != nil {
+		return reading, err
+	}
+
+	return reading, reading.Validate()
+}
+
+// GetRange retrieves blood pressure readings for a date range.
+func (s *BloodPressureService) GetRange(ctx context.Context, start, end time.Time) (*BloodPressureRange, error) {
+	path := fmt.Sprintf("/bloodpressure-service/bloodpressure/range/%s/%s?includeAll=true",
+		start.Format("2006-01-02"), end.Format("2006-01-02"))
+	return fetch[BloodPressureRange](ctx, s.client, path)
+}
+
+// Log records a manual blood pressure reading (mmHg and beats per minute) taken at timestamp.
+func (s *BloodPressureService) Log(ctx context.Context, systolic, diastolic, pulse int, timestamp time.Time, notes string) (*BloodPressureMeasurement, error) {
+	reading := BloodPressureReading{Systolic: systolic, Diastolic: diastolic, Pulse: pulse, Timestamp: timestamp, Notes: notes}
+	if err := reading.Validate(); err != nil {
+		return nil, err
+	}
+
+	body := bloodPressureRequest{
+		MeasurementTimestampLocal: timestamp.Format(bloodPressureTimestampFormat),
+		MeasurementTimestampGMT:   timestamp.UTC().Format(bloodPressureTimestampFormat),
+		Systolic:                  systolic,
+		Diastolic:                 diastolic,
+		Pulse:                     pulse,
+		SourceType:                "MANUAL",
+		Notes:                     notes,
+	}
+	return send[BloodPressureMeasurement](ctx, s.client, http.MethodPost, "/bloodpressure-service/bloodpressure", body)
+}
+
+// Delete deletes a blood pressure reading identified by its date and version.
+func (s *BloodPressureService) Delete(ctx context.Context, date time.Time, version int64) error {
+	path := fmt.Sprintf("/bloodpressure-service/bloodpressure/%s/%d", date.Format("2006-01-02"), version)
+	return sendEmpty(ctx, s.client, http.MethodDelete, path)
+}
@@ -0,0 +1,147 @@
+// service_bloodpressure_test.go
+package garmin
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBloodPressureRangeJSONUnmarshal(t *testing.T) {
+	rawJSON := `{
+		"from": "2026-01-26",
+		"until": "2026-01-27",
+		"measurementSummaries": [
+			{
+				"startDate": "2026-01-26",
+				"endDate": "2026-01-26",
+				"highSystolic": 134,
+				"lowSystolic": 118,
+				"highDiastolic": 86,
+				"lowDiastolic": 76,
+				"numOfMeasurements": 2,
+				"averageSystolic": 126.0,
+				"averageDiastolic": 81.0,
+				"measurements": [
+					{
+						"version": 1769415600000,
+						"systolic": 118,
+						"diastolic": 76,
+						"pulse": 58,
+						"multiMeasurement": false,
+						"sourceType": "MANUAL",
+						"measurementTimestampLocal": "2026-01-26T07:00:00.0",
+						"measurementTimestampGMT": "2026-01-26T06:00:00.0",
+						"notes": "after waking up"
+					},
+					{
+						"version": 1769454000000,
+						"systolic": 134,
+						"diastolic": 86,
+						"pulse": 72,
+						"multiMeasurement": false,
+						"sourceType": "MANUAL",
+						"measurementTimestampLocal": "2026-01-26T19:40:00.0",
+						"measurementTimestampGMT": "2026-01-26T18:40:00.0",
+						"notes": null
+					}
+				]
+			}
+		]
+	}`
+
+	var bp BloodPressureRange
+	if err := json.Unmarshal([]byte(rawJSON), &bp); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	bp.SetRaw(json.RawMessage(rawJSON))
+
+	measurements := bp.Measurements()
+	if len(measurements) != 2 {
+		t.Fatalf("len(Measurements()) = %d, want 2", len(measurements))
+	}
+	if measurements[0].Category() != BloodPressureNormal {
+		t.Errorf("Category() = %s, want %s", measurements[0].Category(), BloodPressureNormal)
+	}
+	if measurements[1].Category() != BloodPressureStage1 {
+		t.Errorf("Category() = %s, want %s", measurements[1].Category(), BloodPressureStage1)
+	}
+	if measurements[1].CalendarDate() != "2026-01-26" {
+		t.Errorf("CalendarDate() = %s, want 2026-01-26", measurements[1].CalendarDate())
+	}
+	if string(bp.RawJSON()) != rawJSON {
+		t.Error("RawJSON should return original JSON")
+	}
+}
+
+func TestClassifyBloodPressure(t *testing.T) {
+	tests := []struct {
+		systolic, diastolic int
+		want                BloodPressureCategory
+	}{
+		{115, 75, BloodPressureNormal},
+		{125, 78, BloodPressureElevated},
+		{125, 82, BloodPressureStage1},
+		{132, 70, BloodPressureStage1},
+		{145, 85, BloodPressureStage2},
+		{128, 92, BloodPressureStage2},
+		{185, 100, BloodPressureCrisis},
+		{150, 125, BloodPressureCrisis},
+	}
+
+	for _, tt := range tests {
+		if got := ClassifyBloodPressure(tt.systolic, tt.diastolic); got != tt.want {
+			t.Errorf("ClassifyBloodPressure(%d, %d) = %s, want %s", tt.systolic, tt.diastolic, got, tt.want)
+		}
+	}
+}
+
+func TestParseBloodPressureCSV(t *testing.T) {
+	csvData := `Timestamp,Systolic,Diastolic,Pulse,Notes
+2026-01-26 07:00,118,76,58,after waking up
+2026-01-26 19:40:30, 134, 86, 72,
+`
+
+	readings, err := ParseBloodPressureCSV(strings.NewReader(csvData))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(readings) != 2 {
+		t.Fatalf("len(readings) = %d, want 2", len(readings))
+	}
+	first := readings[0]
+	if first.Systolic != 118 || first.Diastolic != 76 || first.Pulse != 58 {
+		t.Errorf("first reading = %d/%d %d, want 118/76 58", first.Systolic, first.Diastolic, first.Pulse)
+	}
+	if first.Notes != "after waking up" {
+		t.Errorf("Notes = %q, want %q", first.Notes, "after waking up")
+	}
+	want := time.Date(2026, 1, 26, 19, 40, 30, 0, time.Local)
+	if !readings[1].Timestamp.Equal(want) {
+		t.Errorf("Timestamp = %v, want %v", readings[1].Timestamp, want)
+	}
+}
+
+func TestParseBloodPressureCSVErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		csvData string
+		wantErr string
+	}{
+		{"missing column", "timestamp,systolic,diastolic\n2026-01-26 07:00,118,76\n", "missing column: pulse"},
+		{"invalid value", "timestamp,systolic,diastolic,pulse\n2026-01-26 07:00,abc,76,58\n", "line 2: invalid systolic"},
+		{"invalid timestamp", "timestamp,systolic,diastolic,pulse\n26/01/2026,118,76,58\n", "line 2: invalid timestamp"},
+		{"implausible reading", "timestamp,systolic,diastolic,pulse\n2026-01-26 07:00,76,118,58\n", "line 2: systolic (76) must be greater than diastolic (118)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseBloodPressureCSV(strings.NewReader(tt.csvData))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
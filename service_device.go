@@ -2,11 +2,17 @@
 package garmin
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
 )
 
 // Device represents a Garmin device with its capabilities.
@@ -227,6 +233,93 @@ func (p *PrimaryTrainingDeviceInfo) SetRaw(data json.RawMessage) {
 	p.raw = data
 }
 
+// LastUsedDevice represents the device that last synced with Garmin Connect.
+type LastUsedDevice struct {
+	UserDeviceID                 int64  `json:"userDeviceId"` // device ID
+	UserProfileNumber            int64  `json:"userProfileNumber"`
+	ApplicationNumber            int64  `json:"applicationNumber"`
+	LastUsedDeviceApplicationKey string `json:"lastUsedDeviceApplicationKey"`
+	LastUsedDeviceName           string `json:"lastUsedDeviceName"`
+	LastUsedDeviceUploadTime     int64  `json:"lastUsedDeviceUploadTime"` // Unix milliseconds
+	ImageURL                     string `json:"imageUrl"`
+	Released                     bool   `json:"released"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (d *LastUsedDevice) RawJSON() json.RawMessage {
+	return d.raw
+}
+
+// SetRaw sets the raw JSON data.
+func (d *LastUsedDevice) SetRaw(data json.RawMessage) {
+	d.raw = data
+}
+
+// UploadTime returns the time of the last upload from the device.
+func (d *LastUsedDevice) UploadTime() time.Time {
+	return time.UnixMilli(d.LastUsedDeviceUploadTime)
+}
+
+// SolarReading is the solar intensity measured by a device at a point in time.
+type SolarReading struct {
+	ReadingTimestampGMT   string  `json:"readingTimestampGmt"`
+	ReadingTimestampLocal string  `json:"readingTimestampLocal"`
+	SolarUtilization      float64 `json:"solarUtilization"`   // percent of the full solar intensity
+	ActivityTimeGainMs    int64   `json:"activityTimeGainMs"` // battery time gained during activities
+}
+
+// Time returns the time of the reading.
+func (r *SolarReading) Time() time.Time {
+	t, _ := time.Parse(wellnessTimestampFormat, r.ReadingTimestampGMT)
+	return t
+}
+
+// SolarDay represents the solar readings of a day.
+type SolarDay struct {
+	CalendarDate string         `json:"calendarDate"`
+	Readings     []SolarReading `json:"solarInputReadings"`
+}
+
+// ActivityTimeGain returns the battery time gained from solar charging during
+// activities over the day.
+func (d *SolarDay) ActivityTimeGain() time.Duration {
+	var total int64
+	for _, r := range d.Readings {
+		total += r.ActivityTimeGainMs
+	}
+	return time.Duration(total) * time.Millisecond
+}
+
+// SolarIntake represents the solar charging data of a device over a date range.
+type SolarIntake struct {
+	DeviceID int64      `json:"deviceId"`
+	Days     []SolarDay `json:"solarDailyDataDTOs"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (s *SolarIntake) RawJSON() json.RawMessage {
+	return s.raw
+}
+
+// SetRaw sets the raw JSON data.
+func (s *SolarIntake) SetRaw(data json.RawMessage) {
+	s.raw = data
+}
+
+// UnmarshalJSON unwraps the deviceSolarInput object of the response.
+func (s *SolarIntake) UnmarshalJSON(data []byte) error {
+	type alias SolarIntake
+	var resp struct {
+		DeviceSolarInput *alias `json:"deviceSolarInput"`
+	}
+	resp.DeviceSolarInput = (*alias)(s)
+	return json.Unmarshal(data, &resp)
+}
+
 // GetDevices retrieves the list of registered devices.
 func (s *DeviceService) GetDevices(ctx context.Context) ([]Device, error) {
 	path := "/device-service/deviceregistration/devices"
@@ -276,3 +369,197 @@ func (s *DeviceService) GetPrimaryTrainingDevice(ctx context.Context) (*PrimaryT
 	path := "/web-gateway/device-info/primary-training-device"
 	return fetch[PrimaryTrainingDeviceInfo](ctx, s.client, path)
 }
+
+// GetLastUsed retrieves the device that last synced with Garmin Connect.
+func (s *DeviceService) GetLastUsed(ctx context.Context) (*LastUsedDevice, error) {
+	path := "/device-service/deviceservice/mylastused"
+	return fetch[LastUsedDevice](ctx, s.client, path)
+}
+
+// GetSolarIntake retrieves the solar charging data of a device between two dates.
+func (s *DeviceService) GetSolarIntake(ctx context.Context, deviceID int64, startDate, endDate time.Time) (*SolarIntake, error) {
+	params := url.Values{}
+	params.Set("singleDayView", strconv.FormatBool(startDate.Format("2006-01-02") == endDate.Format("2006-01-02")))
+	path := fmt.Sprintf("/web-gateway/solar/%d/%s/%s?%s", deviceID,
+		startDate.Format("2006-01-02"), endDate.Format("2006-01-02"), params.Encode())
+	return fetch[SolarIntake](ctx, s.client, path)
+}
+
+// UpdateSettings saves the settings of a device. Only the modeled settings that
+// were changed are sent, merged into the device's settings: the settings that
+// DeviceSettings does not model, including unmodeled keys of activityTracking
+// and of each alarm, are sent back unchanged.
+// Start from the settings returned by GetSettings to be able to change any field.
+// For settings built from scratch, only the fields with a non-zero value are
+// applied over the device's current settings, so a field cannot be reset to its
+// zero value (e.g. false) that way.
+func (s *DeviceService) UpdateSettings(ctx context.Context, deviceID int64, settings *DeviceSettings) error {
+	base := settings.raw
+	if base == nil {
+		current, err := s.GetSettings(ctx, deviceID)
+		if err != nil {
+			return fmt.Errorf("get current settings: %w", err)
+		}
+		base = current.raw
+	}
+
+	body, err := settings.mergeInto(base)
+	if err != nil {
+		return err
+	}
+	body["deviceId"] = deviceID
+
+	path := fmt.Sprintf("/device-service/deviceservice/device-info/settings/%d", deviceID)
+	_, err = send[ignoredResponse](ctx, s.client, http.MethodPut, path, body)
+	return err
+}
+
+// Apply applies a partial settings JSON object, which may include settings that
+// DeviceSettings does not model. Objects are merged key by key and other values
+// replaced; a list of alarms replaces the current alarms, each alarm keeping the
+// settings not given of the current alarm with the same alarmId.
+// The settings must come from GetSettings.
+func (s *DeviceSettings) Apply(changes json.RawMessage) error {
+	if s.raw == nil {
+		return errors.New("device settings have no raw JSON to apply changes to")
+	}
+	patch, err := decodeSettingsJSON(changes)
+	if err != nil {
+		return err
+	}
+	if _, ok := patch.(map[string]any); !ok {
+		return errors.New("device settings changes must be a JSON object")
+	}
+
+	body, err := s.mergeInto(s.raw)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(mergeSettingsJSON(body, patch))
+	if err != nil {
+		return err
+	}
+
+	var updated DeviceSettings
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return err
+	}
+	updated.raw = data
+	*s = updated
+	return nil
+}
+
+// deviceSettingsListIDs gives the key identifying the items of the settings lists
+// that are merged item by item.
+var deviceSettingsListIDs = map[string]string{"alarms": "alarmId"}
+
+// mergeInto returns the base settings JSON object with the modeled settings of s
+// that differ from its raw settings (or from zero values if it has none) merged in.
+func (s *DeviceSettings) mergeInto(base json.RawMessage) (map[string]any, error) {
+	var original DeviceSettings
+	if s.raw != nil {
+		if err := json.Unmarshal(s.raw, &original); err != nil {
+			return nil, fmt.Errorf("decode current settings: %w", err)
+		}
+	}
+	current, err := settingsJSON(&original)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := settingsJSON(s)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := decodeSettingsJSON(base)
+	if err != nil {
+		return nil, fmt.Errorf("decode current settings: %w", err)
+	}
+	body, ok := decoded.(map[string]any)
+	if !ok {
+		return nil, errors.New("decode current settings: not a JSON object")
+	}
+	if changes, changed := settingsChanges(current, updated); changed {
+		mergeSettingsJSON(body, changes)
+	}
+	return body, nil
+}
+
+// settingsJSON returns the generic JSON value of the modeled settings.
+func settingsJSON(settings *DeviceSettings) (any, error) {
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	return decodeSettingsJSON(data)
+}
+
+// decodeSettingsJSON decodes a JSON value, keeping numbers as json.Number.
+func decodeSettingsJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// settingsChanges returns the parts of updated that differ from current, and
+// false if there are none. Objects are compared key by key.
+func settingsChanges(current, updated any) (any, bool) {
+	c, cok := current.(map[string]any)
+	u, uok := updated.(map[string]any)
+	if !cok || !uok {
+		return updated, !reflect.DeepEqual(current, updated)
+	}
+	changes := make(map[string]any)
+	for key, value := range u {
+		if change, changed := settingsChanges(c[key], value); changed {
+			changes[key] = change
+		}
+	}
+	return changes, len(changes) > 0
+}
+
+// mergeSettingsJSON merges patch into base and returns the result. Objects are
+// merged key by key, the lists of deviceSettingsListIDs item by item, and other
+// values are replaced.
+func mergeSettingsJSON(base, patch any) any {
+	p, pok := patch.(map[string]any)
+	b, bok := base.(map[string]any)
+	if !pok || !bok {
+		return patch
+	}
+	for key, value := range p {
+		if idKey, ok := deviceSettingsListIDs[key]; ok {
+			b[key] = mergeSettingsList(b[key], value, idKey)
+		} else {
+			b[key] = mergeSettingsJSON(b[key], value)
+		}
+	}
+	return b
+}
+
+// mergeSettingsList replaces a settings list with the patch items, merging each
+// item into the base item with the same ID.
+func mergeSettingsList(base, patch any, idKey string) any {
+	items, ok := patch.([]any)
+	if !ok {
+		return patch
+	}
+	current, _ := base.([]any)
+	for i, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok || obj[idKey] == nil {
+			continue
+		}
+		for _, c := range current {
+			if cur, ok := c.(map[string]any); ok && reflect.DeepEqual(cur[idKey], obj[idKey]) {
+				items[i] = mergeSettingsJSON(cur, obj)
+				break
+			}
+		}
+	}
+	return items
+}
@@ -2,8 +2,11 @@
 package garmin
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
+	"time"
 )
 
 const (
@@ -327,3 +330,233 @@ func TestPrimaryTrainingDeviceInfoRawJSON(t *testing.T) {
 		t.Error("RawJSON should return original JSON")
 	}
 }
+
+func TestLastUsedDeviceJSONUnmarshal(t *testing.T) {
+	rawJSON := `{
+		"userDeviceId": 3456789012,
+		"userProfileNumber": 12345678,
+		"applicationNumber": 4315,
+		"lastUsedDeviceApplicationKey": "fr965",
+		"lastUsedDeviceName": "Forerunner 965",
+		"lastUsedDeviceUploadTime": 1769500800000,
+		"imageUrl": "https://example.com/fr965.png",
+		"released": true
+	}`
+
+	var device LastUsedDevice
+	if err := json.Unmarshal([]byte(rawJSON), &device); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if device.UserDeviceID != 3456789012 || device.LastUsedDeviceName != "Forerunner 965" {
+		t.Errorf("device = %d %q, want 3456789012 %q", device.UserDeviceID, device.LastUsedDeviceName, "Forerunner 965")
+	}
+	if !device.UploadTime().Equal(time.Date(2026, 1, 27, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("UploadTime() = %v, want 2026-01-27 08:00 UTC", device.UploadTime().UTC())
+	}
+}
+
+func TestGetSolarIntake(t *testing.T) {
+	var requested string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		requested = req.URL.Path + "?" + req.URL.RawQuery
+		return http.StatusOK, []byte(`{"deviceSolarInput": {
+			"deviceId": 3456789012,
+			"solarDailyDataDTOs": [{
+				"calendarDate": "2026-01-27",
+				"solarInputReadings": [
+					{"readingTimestampGmt": "2026-01-27T11:00:00.0", "readingTimestampLocal": "2026-01-27T12:00:00.0", "solarUtilization": 35.0, "activityTimeGainMs": 60000},
+					{"readingTimestampGmt": "2026-01-27T12:00:00.0", "readingTimestampLocal": "2026-01-27T13:00:00.0", "solarUtilization": 48.5, "activityTimeGainMs": 30000}
+				]
+			}]
+		}}`)
+	})
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	solar, err := client.Devices.GetSolarIntake(context.Background(), 3456789012, date, date)
+	if err != nil {
+		t.Fatalf("GetSolarIntake failed: %v", err)
+	}
+
+	want := "/web-gateway/solar/3456789012/2026-01-27/2026-01-27?singleDayView=true"
+	if requested != want {
+		t.Errorf("requested %s, want %s", requested, want)
+	}
+	if len(solar.Days) != 1 || len(solar.Days[0].Readings) != 2 {
+		t.Fatalf("Days = %+v, want one day with 2 readings", solar.Days)
+	}
+	if got := solar.Days[0].ActivityTimeGain(); got != 90*time.Second {
+		t.Errorf("ActivityTimeGain() = %v, want 1m30s", got)
+	}
+	if got := solar.Days[0].Readings[1].Time(); !got.Equal(time.Date(2026, 1, 27, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Readings[1].Time() = %v, want 12:00 UTC", got)
+	}
+}
+
+func TestUpdateDeviceSettings(t *testing.T) {
+	var sent map[string]any
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		switch req.Method {
+		case http.MethodGet:
+			return http.StatusOK, []byte(`{"deviceId": 42, "timeFormat": "time_twenty_four_hr", "activityTracking": {"moveAlertEnabled": true}, "backlightSetting": "AUTO"}`)
+		case http.MethodPut:
+			if req.URL.Path != "/device-service/deviceservice/device-info/settings/42" {
+				t.Errorf("path = %s", req.URL.Path)
+			}
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			return http.StatusNoContent, nil
+		}
+		t.Errorf("unexpected %s request", req.Method)
+		return http.StatusMethodNotAllowed, nil
+	})
+	ctx := context.Background()
+
+	settings, err := client.Devices.GetSettings(ctx, 42)
+	if err != nil {
+		t.Fatalf("GetSettings failed: %v", err)
+	}
+	disabled := false
+	settings.ActivityTracking.MoveAlertEnabled = &disabled
+	if err := client.Devices.UpdateSettings(ctx, 42, settings); err != nil {
+		t.Fatalf("UpdateSettings failed: %v", err)
+	}
+
+	// Settings not modeled by DeviceSettings are sent back unchanged
+	if sent["backlightSetting"] != "AUTO" {
+		t.Errorf("backlightSetting = %v, want AUTO", sent["backlightSetting"])
+	}
+	if sent["timeFormat"] != "time_twenty_four_hr" {
+		t.Errorf("timeFormat = %v, want time_twenty_four_hr", sent["timeFormat"])
+	}
+	tracking, _ := sent["activityTracking"].(map[string]any)
+	if tracking["moveAlertEnabled"] != false {
+		t.Errorf("activityTracking = %v, want moveAlertEnabled false", sent["activityTracking"])
+	}
+}
+
+func TestUpdateDeviceSettingsKeepsUnmodeledNestedSettings(t *testing.T) {
+	var sent map[string]any
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		switch req.Method {
+		case http.MethodGet:
+			return http.StatusOK, []byte(`{
+				"deviceId": 42,
+				"timeFormat": "time_twenty_four_hr",
+				"activityTracking": {"moveAlertEnabled": true, "spo2Threshold": 80, "lowSpo2AlertEnabled": true},
+				"alarms": [
+					{"alarmId": 1, "alarmMode": "ON", "alarmTime": 420, "alarmDays": ["MONDAY"], "snoozeDuration": 9},
+					{"alarmId": 2, "alarmMode": "ON", "alarmTime": 480, "alarmDays": ["SATURDAY"], "snoozeDuration": 5}
+				]
+			}`)
+		case http.MethodPut:
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			return http.StatusNoContent, nil
+		}
+		t.Errorf("unexpected %s request", req.Method)
+		return http.StatusMethodNotAllowed, nil
+	})
+	ctx := context.Background()
+
+	settings, err := client.Devices.GetSettings(ctx, 42)
+	if err != nil {
+		t.Fatalf("GetSettings failed: %v", err)
+	}
+	disabled := false
+	settings.ActivityTracking.MoveAlertEnabled = &disabled
+	settings.Alarms = settings.Alarms[:1]
+	settings.Alarms[0].AlarmMode = "OFF"
+	if err := client.Devices.UpdateSettings(ctx, 42, settings); err != nil {
+		t.Fatalf("UpdateSettings failed: %v", err)
+	}
+
+	tracking, _ := sent["activityTracking"].(map[string]any)
+	if tracking["moveAlertEnabled"] != false {
+		t.Errorf("moveAlertEnabled = %v, want false", tracking["moveAlertEnabled"])
+	}
+	if tracking["spo2Threshold"] != float64(80) || tracking["lowSpo2AlertEnabled"] != true {
+		t.Errorf("activityTracking = %v, want unmodeled keys kept", tracking)
+	}
+	alarms, _ := sent["alarms"].([]any)
+	if len(alarms) != 1 {
+		t.Fatalf("alarms = %v, want only alarm 1", sent["alarms"])
+	}
+	alarm, _ := alarms[0].(map[string]any)
+	if alarm["alarmMode"] != "OFF" || alarm["snoozeDuration"] != float64(9) {
+		t.Errorf("alarm = %v, want alarmMode OFF and snoozeDuration kept", alarm)
+	}
+}
+
+func TestUpdateDeviceSettingsFromScratch(t *testing.T) {
+	var sent map[string]any
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		switch req.Method {
+		case http.MethodGet:
+			return http.StatusOK, []byte(`{"deviceId": 42, "timeFormat": "time_twelve_hr", "language": 3, "startOfWeek": "MONDAY", "liveTrackEnabled": true, "alarms": [{"alarmId": 1, "alarmMode": "ON"}]}`)
+		case http.MethodPut:
+			if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			return http.StatusNoContent, nil
+		}
+		t.Errorf("unexpected %s request", req.Method)
+		return http.StatusMethodNotAllowed, nil
+	})
+
+	enabled := true
+	settings := &DeviceSettings{TimeFormat: "time_twenty_four_hr", ActivityTracking: &ActivityTracking{MoveAlertEnabled: &enabled}}
+	if err := client.Devices.UpdateSettings(context.Background(), 42, settings); err != nil {
+		t.Fatalf("UpdateSettings failed: %v", err)
+	}
+
+	if sent["timeFormat"] != "time_twenty_four_hr" {
+		t.Errorf("timeFormat = %v, want time_twenty_four_hr", sent["timeFormat"])
+	}
+	// Zero values of fields not set are not sent over the current settings
+	if sent["language"] != float64(3) || sent["startOfWeek"] != "MONDAY" || sent["liveTrackEnabled"] != true {
+		t.Errorf("settings = %v, want current language, startOfWeek and liveTrackEnabled kept", sent)
+	}
+	if alarms, _ := sent["alarms"].([]any); len(alarms) != 1 {
+		t.Errorf("alarms = %v, want current alarm kept", sent["alarms"])
+	}
+}
+
+func TestDeviceSettingsApply(t *testing.T) {
+	current := `{"deviceId": 42, "activityTracking": {"moveAlertEnabled": true, "spo2Threshold": 80}, "alarms": [{"alarmId": 1, "alarmMode": "ON", "alarmTime": 420, "snoozeDuration": 9}]}`
+	var settings DeviceSettings
+	if err := json.Unmarshal([]byte(current), &settings); err != nil {
+		t.Fatal(err)
+	}
+	settings.SetRaw(json.RawMessage(current))
+
+	if err := settings.Apply(json.RawMessage(`{"activityTracking": {"spo2Threshold": 85}, "alarms": [{"alarmId": 1, "alarmMode": "OFF"}]}`)); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	if settings.ActivityTracking == nil || settings.ActivityTracking.MoveAlertEnabled == nil || !*settings.ActivityTracking.MoveAlertEnabled {
+		t.Error("expected moveAlertEnabled to be left unchanged")
+	}
+	if len(settings.Alarms) != 1 || settings.Alarms[0].AlarmMode != "OFF" || settings.Alarms[0].AlarmTime != 420 {
+		t.Errorf("Alarms = %+v, want alarm 1 turned off at 420", settings.Alarms)
+	}
+	var raw struct {
+		ActivityTracking map[string]any   `json:"activityTracking"`
+		Alarms           []map[string]any `json:"alarms"`
+	}
+	if err := json.Unmarshal(settings.RawJSON(), &raw); err != nil {
+		t.Fatal(err)
+	}
+	if raw.ActivityTracking["spo2Threshold"] != float64(85) {
+		t.Errorf("spo2Threshold = %v, want 85", raw.ActivityTracking["spo2Threshold"])
+	}
+	if len(raw.Alarms) != 1 || raw.Alarms[0]["snoozeDuration"] != float64(9) {
+		t.Errorf("alarms = %v, want snoozeDuration kept", raw.Alarms)
+	}
+
+	if err := settings.Apply(json.RawMessage(`[1, 2]`)); err == nil {
+		t.Error("expected error for changes that are not an object")
+	}
+}
@@ -0,0 +1,282 @@
+// service_download.go
+package garmin
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// defaultBulkConcurrency is the number of parallel downloads when none is set.
+const defaultBulkConcurrency = 2
+
+// zipMagic is the signature at the start of a zip archive.
+var zipMagic = []byte("PK\x03\x04")
+
+// ActivityFormat is the file format of an activity download.
+type ActivityFormat string
+
+// Activity download formats.
+const (
+	ActivityFormatFIT ActivityFormat = "fit" // original file, unzipped
+	ActivityFormatTCX ActivityFormat = "tcx"
+	ActivityFormatGPX ActivityFormat = "gpx"
+	ActivityFormatKML ActivityFormat = "kml"
+	ActivityFormatCSV ActivityFormat = "csv"
+)
+
+// path returns the download path of an activity in this format.
+func (f ActivityFormat) path(activityID int64) (string, error) {
+	switch f {
+	case ActivityFormatFIT:
+		return fmt.Sprintf("/download-service/files/activity/%d", activityID), nil
+	case ActivityFormatTCX, ActivityFormatGPX, ActivityFormatKML, ActivityFormatCSV:
+		return fmt.Sprintf("/download-service/export/%s/activity/%d", f, activityID), nil
+	default:
+		return "", fmt.Errorf("unsupported activity format: %q", f)
+	}
+}
+
+// FileName returns the file name used for an activity in this format (e.g. "123.fit").
+func (f ActivityFormat) FileName(activityID int64) string {
+	return fmt.Sprintf("%d.%s", activityID, f)
+}
+
+// BulkDownloadOptions configures a bulk activity download.
+type BulkDownloadOptions struct {
+	// Dir is the destination directory, created if missing.
+	Dir string
+	// Format is the file format (defaults to FIT).
+	Format ActivityFormat
+	// Concurrency is the number of parallel downloads (defaults to 2). All downloads
+	// share the client's rate limiter, so it mostly overlaps transfer time.
+	Concurrency int
+	// Overwrite re-downloads activities whose file already exists.
+	Overwrite bool
+	// OnProgress is called after each activity is processed. Calls are serialized.
+	OnProgress func(BulkDownloadResult)
+}
+
+// BulkDownloadResult is the outcome of downloading a single activity.
+type BulkDownloadResult struct {
+	ActivityID int64  `json:"activityId"`
+	Path       string `json:"path"`
+	Bytes      int64  `json:"bytes"`
+	Skipped    bool   `json:"skipped"` // file already existed
+	Err        error  `json:"-"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Original streams the original file archive (a zip containing the FIT file) of an activity to w.
+func (s *DownloadService) Original(ctx context.Context, activityID int64, w io.Writer) (int64, error) {
+	path, _ := ActivityFormatFIT.path(activityID)
+	body, err := s.open(ctx, path)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	return io.Copy(w, body)
+}
+
+// Activity streams an activity file in the given format to w. For FIT, the original
+// file archive is unzipped and the FIT file it contains is written.
+func (s *DownloadService) Activity(ctx context.Context, activityID int64, format ActivityFormat, w io.Writer) (int64, error) {
+	path, err := format.path(activityID)
+	if err != nil {
+		return 0, err
+	}
+	body, err := s.open(ctx, path)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	if format != ActivityFormatFIT {
+		return io.Copy(w, body)
+	}
+	return copyUnzipped(w, body)
+}
+
+// Bulk downloads activities to files named "<activityID>.<format>" in opts.Dir.
+// Downloads are resumable: existing files are skipped unless opts.Overwrite is set,
+// and files are written under a temporary name until complete. Results are returned
+// in the order of activityIDs; failed downloads are reported in the results and in
+// the joined error.
+func (s *DownloadService) Bulk(ctx context.Context, activityIDs []int64, opts BulkDownloadOptions) ([]BulkDownloadResult, error) {
+	if opts.Format == "" {
+		opts.Format = ActivityFormatFIT
+	}
+	if _, err := opts.Format.path(0); err != nil {
+		return nil, err
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultBulkConcurrency
+	}
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create directory: %w", err)
+	}
+
+	results := make([]BulkDownloadResult, len(activityIDs))
+	for i, id := range activityIDs {
+		results[i].ActivityID = id
+	}
+	indexes := make(chan int)
+	var progressMu sync.Mutex
+	var wg sync.WaitGroup
+
+	for range min(opts.Concurrency, len(activityIDs)) {
+		wg.Go(func() {
+			for i := range indexes {
+				results[i] = s.downloadToFile(ctx, activityIDs[i], &opts)
+				if opts.OnProgress != nil {
+					progressMu.Lock()
+					opts.OnProgress(results[i])
+					progressMu.Unlock()
+				}
+			}
+		})
+	}
+
+feed:
+	for i := range activityIDs {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return results, err
+	}
+	var errs []error
+	for i := range results {
+		if results[i].Err != nil {
+			errs = append(errs, fmt.Errorf("activity %d: %w", results[i].ActivityID, results[i].Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// downloadToFile downloads a single activity for Bulk.
+func (s *DownloadService) downloadToFile(ctx context.Context, activityID int64, opts *BulkDownloadOptions) BulkDownloadResult {
+	result := BulkDownloadResult{
+		ActivityID: activityID,
+		Path:       filepath.Join(opts.Dir, opts.Format.FileName(activityID)),
+	}
+
+	if info, err := os.Stat(result.Path); err == nil && info.Size() > 0 && !opts.Overwrite {
+		result.Skipped = true
+		result.Bytes = info.Size()
+		return result
+	}
+
+	result.Bytes, result.Err = s.ActivityToFile(ctx, activityID, opts.Format, result.Path)
+	if result.Err != nil {
+		result.Error = result.Err.Error()
+	}
+	return result
+}
+
+// ActivityToFile downloads an activity to path. The file is written under a temporary
+// name and renamed once complete, so an existing file is only replaced by a full download.
+func (s *DownloadService) ActivityToFile(ctx context.Context, activityID int64, format ActivityFormat, path string) (int64, error) {
+	tmp := path + ".part"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := s.Activity(ctx, activityID, format, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return 0, err
+	}
+
+	return n, os.Rename(tmp, path)
+}
+
+// open performs a download request and returns the response body.
+// Returns ErrNotFound if the activity does not exist.
+func (s *DownloadService) open(ctx context.Context, path string) (io.ReadCloser, error) {
+	resp, err := s.client.doAPI(ctx, http.MethodGet, path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNoContent {
+		resp.Body.Close()
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		raw, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Body: raw}
+	}
+
+	return resp.Body, nil
+}
+
+// copyUnzipped writes the FIT file of a zip archive read from r to w. The archive is
+// spooled to a temporary file rather than memory. Data that is not a zip archive is
+// copied unchanged.
+func copyUnzipped(w io.Writer, r io.Reader) (int64, error) {
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(len(zipMagic)); !bytes.Equal(magic, zipMagic) {
+		return io.Copy(w, br)
+	}
+
+	tmp, err := os.CreateTemp("", "garmin-activity-*.zip")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	size, err := io.Copy(tmp, br)
+	if err != nil {
+		return 0, err
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return 0, fmt.Errorf("read zip archive: %w", err)
+	}
+	if len(zr.File) == 0 {
+		return 0, errors.New("empty zip archive")
+	}
+
+	// Prefer the FIT file if the archive contains several files
+	file := zr.File[0]
+	for _, f := range zr.File {
+		if strings.EqualFold(filepath.Ext(f.Name), ".fit") {
+			file = f
+			break
+		}
+	}
+
+	rc, err := file.Open()
+	if err != nil {
+		return 0, fmt.Errorf("open %s: %w", file.Name, err)
+	}
+	defer rc.Close()
+
+	return io.Copy(w, rc)
+}
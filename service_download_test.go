@@ -0,0 +1,239 @@
+// service_download_test.go
+package garmin
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// zipArchive builds a zip archive with the given files.
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("create zip entry: %v", err)
+		}
+		if _, err := io.WriteString(w, content); err != nil {
+			t.Fatalf("write zip entry: %v", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("close zip: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestActivityFormatPath(t *testing.T) {
+	tests := []struct {
+		format ActivityFormat
+		want   string
+	}{
+		{ActivityFormatFIT, "/download-service/files/activity/42"},
+		{ActivityFormatTCX, "/download-service/export/tcx/activity/42"},
+		{ActivityFormatGPX, "/download-service/export/gpx/activity/42"},
+		{ActivityFormatKML, "/download-service/export/kml/activity/42"},
+		{ActivityFormatCSV, "/download-service/export/csv/activity/42"},
+	}
+	for _, tt := range tests {
+		got, err := tt.format.path(42)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.format, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: path = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+
+	if _, err := ActivityFormat("pdf").path(42); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+	if got := ActivityFormatGPX.FileName(42); got != "42.gpx" {
+		t.Errorf("FileName = %q, want %q", got, "42.gpx")
+	}
+}
+
+func TestCopyUnzipped(t *testing.T) {
+	t.Run("prefers FIT entry", func(t *testing.T) {
+		archive := zipArchive(t, map[string]string{
+			"readme.txt":      "not this one",
+			"42_ACTIVITY.fit": "fit data",
+		})
+		var out bytes.Buffer
+		n, err := copyUnzipped(&out, bytes.NewReader(archive))
+		if err != nil {
+			t.Fatalf("copyUnzipped failed: %v", err)
+		}
+		if out.String() != "fit data" || n != int64(len("fit data")) {
+			t.Errorf("copyUnzipped wrote %q (%d bytes), want %q", out.String(), n, "fit data")
+		}
+	})
+
+	t.Run("passes through non-zip data", func(t *testing.T) {
+		var out bytes.Buffer
+		if _, err := copyUnzipped(&out, strings.NewReader("<gpx/>")); err != nil {
+			t.Fatalf("copyUnzipped failed: %v", err)
+		}
+		if out.String() != "<gpx/>" {
+			t.Errorf("copyUnzipped wrote %q, want %q", out.String(), "<gpx/>")
+		}
+	})
+
+	t.Run("corrupt archive", func(t *testing.T) {
+		if _, err := copyUnzipped(io.Discard, strings.NewReader("PK\x03\x04garbage")); err == nil {
+			t.Error("expected error for corrupt archive")
+		}
+	})
+}
+
+func TestDownloadActivity(t *testing.T) {
+	archive := zipArchive(t, map[string]string{"42_ACTIVITY.fit": "fit data"})
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		switch req.URL.Path {
+		case "/download-service/files/activity/42":
+			return http.StatusOK, archive
+		case "/download-service/export/gpx/activity/42":
+			return http.StatusOK, []byte("<gpx/>")
+		default:
+			return http.StatusNotFound, nil
+		}
+	})
+	ctx := context.Background()
+
+	var fit bytes.Buffer
+	if _, err := client.Download.Activity(ctx, 42, ActivityFormatFIT, &fit); err != nil {
+		t.Fatalf("Activity(fit) failed: %v", err)
+	}
+	if fit.String() != "fit data" {
+		t.Errorf("Activity(fit) wrote %q, want %q", fit.String(), "fit data")
+	}
+
+	var original bytes.Buffer
+	if _, err := client.Download.Original(ctx, 42, &original); err != nil {
+		t.Fatalf("Original failed: %v", err)
+	}
+	if !bytes.Equal(original.Bytes(), archive) {
+		t.Error("Original did not write the zip archive unchanged")
+	}
+
+	var gpx bytes.Buffer
+	if _, err := client.Download.Activity(ctx, 42, ActivityFormatGPX, &gpx); err != nil {
+		t.Fatalf("Activity(gpx) failed: %v", err)
+	}
+	if gpx.String() != "<gpx/>" {
+		t.Errorf("Activity(gpx) wrote %q, want %q", gpx.String(), "<gpx/>")
+	}
+
+	if _, err := client.Download.Activity(ctx, 7, ActivityFormatFIT, io.Discard); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Activity(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestDownloadBulk(t *testing.T) {
+	var mu sync.Mutex
+	var requested []string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		mu.Lock()
+		requested = append(requested, req.URL.Path)
+		mu.Unlock()
+		switch req.URL.Path {
+		case "/download-service/export/gpx/activity/1":
+			return http.StatusOK, []byte("<gpx>1</gpx>")
+		case "/download-service/export/gpx/activity/3":
+			return http.StatusInternalServerError, []byte("boom")
+		default:
+			return http.StatusNotFound, nil
+		}
+	})
+
+	dir := t.TempDir()
+	// Activity 2 was downloaded by a previous run and must be skipped
+	if err := os.WriteFile(filepath.Join(dir, "2.gpx"), []byte("<gpx>2</gpx>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var progress int
+	results, err := client.Download.Bulk(context.Background(), []int64{1, 2, 3}, BulkDownloadOptions{
+		Dir:         dir,
+		Format:      ActivityFormatGPX,
+		Concurrency: 3,
+		OnProgress:  func(BulkDownloadResult) { progress++ },
+	})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Bulk error = %v, want joined APIError 500", err)
+	}
+	if len(results) != 3 || progress != 3 {
+		t.Fatalf("got %d results and %d progress calls, want 3 each", len(results), progress)
+	}
+
+	if r := results[0]; r.ActivityID != 1 || r.Err != nil || r.Skipped || r.Bytes != int64(len("<gpx>1</gpx>")) {
+		t.Errorf("results[0] = %+v, want downloaded activity 1", r)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "1.gpx")); string(data) != "<gpx>1</gpx>" {
+		t.Errorf("1.gpx = %q, want %q", data, "<gpx>1</gpx>")
+	}
+	if r := results[1]; r.ActivityID != 2 || !r.Skipped {
+		t.Errorf("results[1] = %+v, want skipped activity 2", r)
+	}
+	if r := results[2]; r.ActivityID != 3 || r.Err == nil || r.Error == "" {
+		t.Errorf("results[2] = %+v, want failed activity 3", r)
+	}
+	for _, name := range []string{"3.gpx", "3.gpx.part"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Errorf("%s should not exist after a failed download", name)
+		}
+	}
+	for _, path := range requested {
+		if strings.HasSuffix(path, "/2") {
+			t.Errorf("existing file was downloaded again: %s", path)
+		}
+	}
+}
+
+func TestDownloadActivityToFileKeepsExistingFileOnError(t *testing.T) {
+	client := newFakeClient(t, func(*http.Request) (int, []byte) {
+		return http.StatusInternalServerError, []byte("boom")
+	})
+
+	path := filepath.Join(t.TempDir(), "run.gpx")
+	if err := os.WriteFile(path, []byte("<gpx>old</gpx>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Download.ActivityToFile(context.Background(), 1, ActivityFormatGPX, path); err == nil {
+		t.Fatal("expected error")
+	}
+	if data, _ := os.ReadFile(path); string(data) != "<gpx>old</gpx>" {
+		t.Errorf("run.gpx = %q, want the previous content", data)
+	}
+	if _, err := os.Stat(path + ".part"); !os.IsNotExist(err) {
+		t.Error("run.gpx.part should not exist after a failed download")
+	}
+}
+
+func TestDownloadBulkUnsupportedFormat(t *testing.T) {
+	client := newFakeClient(t, func(*http.Request) (int, []byte) {
+		t.Error("unexpected request")
+		return http.StatusOK, nil
+	})
+	_, err := client.Download.Bulk(context.Background(), []int64{1}, BulkDownloadOptions{
+		Dir:    t.TempDir(),
+		Format: ActivityFormat("pdf"),
+	})
+	if err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
@@ -0,0 +1,226 @@
+// service_gear.go
+package garmin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"time"
+)
+
+// Gear status values.
+const (
+	GearStatusActive  = "active"
+	GearStatusRetired = "retired"
+)
+
+// GearList represents the list of gear owned by a user.
+type GearList struct {
+	Items []GearItem
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (g *GearList) RawJSON() json.RawMessage {
+	return g.raw
+}
+
+// SetRaw sets the raw JSON data.
+func (g *GearList) SetRaw(data json.RawMessage) {
+	g.raw = data
+}
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+// Each item keeps its own raw JSON, so that it can be updated without losing fields.
+func (g *GearList) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	g.Items = make([]GearItem, len(items))
+	for i, item := range items {
+		if err := json.Unmarshal(item, &g.Items[i]); err != nil {
+			return err
+		}
+		g.Items[i].raw = item
+	}
+	return nil
+}
+
+// Find returns the gear with the given UUID, or nil if not found.
+func (g *GearList) Find(gearUUID string) *GearItem {
+	for i := range g.Items {
+		if g.Items[i].UUID == gearUUID {
+			return &g.Items[i]
+		}
+	}
+	return nil
+}
+
+// GearStats represents usage statistics for a piece of gear.
+type GearStats struct {
+	UUID            string  `json:"uuid"`
+	GearPk          int64   `json:"gearPk"`
+	TotalActivities int     `json:"totalActivities"`
+	TotalDistance   float64 `json:"totalDistance"`
+	Processing      bool    `json:"processing"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (g *GearStats) RawJSON() json.RawMessage {
+	return g.raw
+}
+
+// SetRaw sets the raw JSON data.
+func (g *GearStats) SetRaw(data json.RawMessage) {
+	g.raw = data
+}
+
+// DistanceKm returns the total distance in kilometers.
+func (g *GearStats) DistanceKm() float64 {
+	return g.TotalDistance / 1000
+}
+
+// DistanceMiles returns the total distance in miles.
+func (g *GearStats) DistanceMiles() float64 {
+	return g.TotalDistance / 1609.344
+}
+
+// GearDefault represents a piece of gear used by default for an activity type.
+type GearDefault struct {
+	GearPk         int64  `json:"gearPk"`
+	UUID           string `json:"uuid"`
+	ActivityTypePk int64  `json:"activityTypePk"`
+	DefaultGear    bool   `json:"defaultGear"`
+}
+
+// GearDefaults represents the default gear configured per activity type.
+type GearDefaults struct {
+	Items []GearDefault
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (g *GearDefaults) RawJSON() json.RawMessage {
+	return g.raw
+}
+
+// SetRaw sets the raw JSON data.
+func (g *GearDefaults) SetRaw(data json.RawMessage) {
+	g.raw = data
+}
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (g *GearDefaults) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &g.Items)
+}
+
+// List retrieves all gear owned by the user.
+// The userProfilePK is the profileId from the user's social profile.
+func (s *GearService) List(ctx context.Context, userProfilePK int64) (*GearList, error) {
+	path := fmt.Sprintf("/gear-service/gear/filterGear?userProfilePk=%d", userProfilePK)
+	return fetch[GearList](ctx, s.client, path)
+}
+
+// GetStats retrieves usage statistics (total distance and activities) for a piece of gear.
+func (s *GearService) GetStats(ctx context.Context, gearUUID string) (*GearStats, error) {
+	return fetch[GearStats](ctx, s.client, "/gear-service/gear/stats/"+gearUUID)
+}
+
+// GetActivities retrieves the activities recorded with a piece of gear.
+func (s *GearService) GetActivities(ctx context.Context, gearUUID string, opts *ListOptions) ([]Activity, error) {
+	start, limit := opts.values()
+	path := fmt.Sprintf("/activitylist-service/activities/%s/gear?start=%d&limit=%d", gearUUID, start, limit)
+	return fetchActivities(ctx, s.client, path)
+}
+
+// LinkToActivity links a piece of gear to an activity.
+func (s *GearService) LinkToActivity(ctx context.Context, gearUUID string, activityID int64) error {
+	path := fmt.Sprintf("/gear-service/gear/link/%s/activity/%d", gearUUID, activityID)
+	return sendEmpty(ctx, s.client, http.MethodPut, path)
+}
+
+// UnlinkFromActivity removes the link between a piece of gear and an activity.
+func (s *GearService) UnlinkFromActivity(ctx context.Context, gearUUID string, activityID int64) error {
+	path := fmt.Sprintf("/gear-service/gear/unlink/%s/activity/%d", gearUUID, activityID)
+	return sendEmpty(ctx, s.client, http.MethodPut, path)
+}
+
+// GetDefaults retrieves the default gear configured per activity type.
+func (s *GearService) GetDefaults(ctx context.Context, userProfilePK int64) (*GearDefaults, error) {
+	path := fmt.Sprintf("/gear-service/gear/user/%d/activityTypes", userProfilePK)
+	return fetch[GearDefaults](ctx, s.client, path)
+}
+
+// SetDefault makes a piece of gear the default for an activity type.
+func (s *GearService) SetDefault(ctx context.Context, gearUUID string, activityTypeID int64) error {
+	path := fmt.Sprintf("/gear-service/gear/%s/activityType/%d/default/true", gearUUID, activityTypeID)
+	return sendEmpty(ctx, s.client, http.MethodPut, path)
+}
+
+// RemoveDefault stops using a piece of gear as the default for an activity type.
+func (s *GearService) RemoveDefault(ctx context.Context, gearUUID string, activityTypeID int64) error {
+	path := fmt.Sprintf("/gear-service/gear/%s/activityType/%d", gearUUID, activityTypeID)
+	return sendEmpty(ctx, s.client, http.MethodDelete, path)
+}
+
+// Update updates a piece of gear. The fields of gear are overlaid on its raw JSON,
+// so that fields this package does not model are sent back unchanged.
+func (s *GearService) Update(ctx context.Context, gear *GearItem) (*GearItem, error) {
+	if gear.UUID == "" {
+		return nil, errors.New("gear UUID is required")
+	}
+	body, err := gearBody(gear)
+	if err != nil {
+		return nil, err
+	}
+	return send[GearItem](ctx, s.client, http.MethodPut, "/gear-service/gear/"+gear.UUID, body)
+}
+
+// Retire marks a piece of gear as retired as of the given date.
+func (s *GearService) Retire(ctx context.Context, gear *GearItem, date time.Time) (*GearItem, error) {
+	retired := *gear
+	retired.GearStatusName = GearStatusRetired
+	dateEnd := date.Format("2006-01-02") + "T00:00:00.0"
+	retired.DateEnd = &dateEnd
+	return s.Update(ctx, &retired)
+}
+
+// gearBody returns the raw JSON of gear with its modeled fields overlaid.
+func gearBody(gear *GearItem) (map[string]any, error) {
+	body, err := decodeGearJSON(gear.raw)
+	if err != nil {
+		return nil, fmt.Errorf("decode gear: %w", err)
+	}
+	data, err := json.Marshal(gear)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := decodeGearJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(body, fields)
+	return body, nil
+}
+
+// decodeGearJSON decodes a gear object, keeping numbers as json.Number so that
+// unmodeled values are sent back exactly as received.
+func decodeGearJSON(data []byte) (map[string]any, error) {
+	body := make(map[string]any)
+	if len(data) == 0 {
+		return body, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
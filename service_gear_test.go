@@ -0,0 +1,215 @@
+// service_gear_test.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGearListJSONUnmarshal(t *testing.T) {
+	rawJSON := `[
+		{
+			"gearPk": 1234567,
+			"uuid": "a1b2c3d4e5f60718293a4b5c6d7e8f90",
+			"userProfilePk": 12345678,
+			"gearMakeName": "Asics",
+			"gearModelName": "Novablast 4",
+			"gearTypeName": "Shoes",
+			"gearStatusName": "active",
+			"displayName": "Daily trainer",
+			"customMakeModel": "Asics Novablast 4",
+			"imageNameLarge": null,
+			"imageNameMedium": null,
+			"imageNameSmall": null,
+			"dateBegin": "2025-03-01T00:00:00.0",
+			"dateEnd": null,
+			"maximumMeters": 800000,
+			"notified": false,
+			"createDate": "2025-03-01T10:12:45.0",
+			"updateDate": "2025-03-01T10:12:45.0"
+		},
+		{
+			"gearPk": 7654321,
+			"uuid": "0f9e8d7c6b5a49382716050403020100",
+			"userProfilePk": 12345678,
+			"gearMakeName": "Other",
+			"gearModelName": "Unknown Shoes",
+			"gearTypeName": "Shoes",
+			"gearStatusName": "retired",
+			"displayName": "Old racers",
+			"dateBegin": "2023-01-15T00:00:00.0",
+			"dateEnd": "2024-06-30T00:00:00.0",
+			"createDate": "2023-01-15T08:00:00.0",
+			"updateDate": "2024-06-30T08:00:00.0"
+		}
+	]`
+
+	var gear GearList
+	if err := json.Unmarshal([]byte(rawJSON), &gear); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if len(gear.Items) != 2 {
+		t.Fatalf("len(Items) = %d, want 2", len(gear.Items))
+	}
+
+	first := gear.Items[0]
+	if first.UUID != "a1b2c3d4e5f60718293a4b5c6d7e8f90" {
+		t.Errorf("UUID = %s, want a1b2c3d4e5f60718293a4b5c6d7e8f90", first.UUID)
+	}
+	if first.UserProfilePK != 12345678 {
+		t.Errorf("UserProfilePK = %d, want 12345678", first.UserProfilePK)
+	}
+	if first.GearStatusName != GearStatusActive {
+		t.Errorf("GearStatusName = %s, want %s", first.GearStatusName, GearStatusActive)
+	}
+	if first.MaximumMeters == nil || *first.MaximumMeters != 800000 {
+		t.Errorf("MaximumMeters = %v, want 800000", first.MaximumMeters)
+	}
+	if gear.Items[1].GearStatusName != GearStatusRetired {
+		t.Errorf("GearStatusName = %s, want %s", gear.Items[1].GearStatusName, GearStatusRetired)
+	}
+}
+
+func TestGearListFind(t *testing.T) {
+	gear := GearList{Items: []GearItem{
+		{UUID: "aaa", DisplayName: "First"},
+		{UUID: "bbb", DisplayName: "Second"},
+	}}
+
+	found := gear.Find("bbb")
+	if found == nil || found.DisplayName != "Second" {
+		t.Errorf("Find(bbb) = %v, want Second", found)
+	}
+	if gear.Find("ccc") != nil {
+		t.Error("Find(ccc) should return nil")
+	}
+}
+
+func TestGearStatsJSONUnmarshal(t *testing.T) {
+	rawJSON := `{
+		"uuid": "a1b2c3d4e5f60718293a4b5c6d7e8f90",
+		"gearPk": 1234567,
+		"totalActivities": 52,
+		"totalDistance": 412345.6,
+		"processing": false
+	}`
+
+	var stats GearStats
+	if err := json.Unmarshal([]byte(rawJSON), &stats); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if stats.TotalActivities != 52 {
+		t.Errorf("TotalActivities = %d, want 52", stats.TotalActivities)
+	}
+	if math.Abs(stats.DistanceKm()-412.3456) > 0.0001 {
+		t.Errorf("DistanceKm() = %f, want 412.3456", stats.DistanceKm())
+	}
+	if math.Abs(stats.DistanceMiles()-256.2194) > 0.001 {
+		t.Errorf("DistanceMiles() = %f, want ~256.2194", stats.DistanceMiles())
+	}
+}
+
+func TestGearDefaultsJSONUnmarshal(t *testing.T) {
+	rawJSON := `[
+		{"gearPk": 1234567, "uuid": "a1b2c3d4e5f60718293a4b5c6d7e8f90", "activityTypePk": 1, "defaultGear": true},
+		{"gearPk": 2345678, "uuid": "b2c3d4e5f60718293a4b5c6d7e8f90a1", "activityTypePk": 2, "defaultGear": true}
+	]`
+
+	var defaults GearDefaults
+	if err := json.Unmarshal([]byte(rawJSON), &defaults); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if len(defaults.Items) != 2 {
+		t.Fatalf("len(Items) = %d, want 2", len(defaults.Items))
+	}
+	if defaults.Items[1].ActivityTypePk != 2 {
+		t.Errorf("ActivityTypePk = %d, want 2", defaults.Items[1].ActivityTypePk)
+	}
+	if !defaults.Items[0].DefaultGear {
+		t.Error("expected DefaultGear to be true")
+	}
+}
+
+func TestGearListRawJSON(t *testing.T) {
+	rawJSON := `[{"uuid":"aaa"}]`
+
+	var gear GearList
+	if err := json.Unmarshal([]byte(rawJSON), &gear); err != nil {
+		t.Fatal(err)
+	}
+	gear.SetRaw(json.RawMessage(rawJSON))
+
+	if string(gear.RawJSON()) != rawJSON {
+		t.Error("RawJSON should return original JSON")
+	}
+}
+
+func TestGearRetireKeepsUnmodeledFields(t *testing.T) {
+	var body map[string]any
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.Method != http.MethodPut || req.URL.Path != "/gear-service/gear/aaa" {
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		data, _ := io.ReadAll(req.Body)
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+		return http.StatusOK, data
+	})
+
+	var gear GearList
+	rawJSON := `[{"uuid":"aaa","gearPk":1234567,"gearStatusName":"active","dateEnd":null,"displayName":"Daily trainer","gearMakeId":42}]`
+	if err := json.Unmarshal([]byte(rawJSON), &gear); err != nil {
+		t.Fatal(err)
+	}
+
+	retired, err := client.Gear.Retire(context.Background(), gear.Find("aaa"), time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Retire failed: %v", err)
+	}
+
+	want := map[string]any{
+		"gearStatusName": GearStatusRetired,
+		"dateEnd":        "2026-01-27T00:00:00.0",
+		"displayName":    "Daily trainer",
+		"gearMakeId":     42.0,
+	}
+	for key, value := range want {
+		if body[key] != value {
+			t.Errorf("body[%q] = %v, want %v", key, body[key], value)
+		}
+	}
+	if retired.GearStatusName != GearStatusRetired {
+		t.Errorf("GearStatusName = %s, want %s", retired.GearStatusName, GearStatusRetired)
+	}
+}
+
+func TestListOptionsValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      *ListOptions
+		wantStart int
+		wantLimit int
+	}{
+		{"nil", nil, 0, 20},
+		{"zero", &ListOptions{}, 0, 20},
+		{"custom", &ListOptions{Start: 40, Limit: 10}, 40, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, limit := tt.opts.values()
+			if start != tt.wantStart || limit != tt.wantLimit {
+				t.Errorf("values() = (%d, %d), want (%d, %d)", start, limit, tt.wantStart, tt.wantLimit)
+			}
+		})
+	}
+}
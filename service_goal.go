@@ -0,0 +1,311 @@
+// service_goal.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// GoalStatus filters goals by their state.
+type GoalStatus string
+
+const (
+	GoalStatusActive GoalStatus = "active"
+	GoalStatusFuture GoalStatus = "future"
+	GoalStatusPast   GoalStatus = "past"
+)
+
+// GoalType identifies what a goal measures.
+type GoalType string
+
+const (
+	GoalTypeSteps      GoalType = "steps"      // daily steps
+	GoalTypeWeight     GoalType = "weight"     // target weight in grams
+	GoalTypeDistance   GoalType = "distance"   // activity distance in meters
+	GoalTypeDuration   GoalType = "duration"   // activity duration in seconds
+	GoalTypeActivities GoalType = "activities" // number of activities
+)
+
+// goalActivityPageSize is the page size used when scanning activities for goal progress.
+const goalActivityPageSize = 100
+
+// Goal represents a user goal.
+type Goal struct {
+	ID                 int64    `json:"id,omitempty"`
+	UserProfilePK      int64    `json:"userProfilePk,omitempty"`
+	GoalType           GoalType `json:"goalType"`
+	GoalName           string   `json:"goalName,omitempty"`
+	GoalValue          float64  `json:"goalValue"`
+	StartDate          string   `json:"startDate"`
+	EndDate            *string  `json:"endDate,omitempty"`
+	CreateDate         string   `json:"createDate,omitempty"`
+	ActivityTypePK     *int     `json:"activityTypePk,omitempty"`
+	TrackingPeriodType *string  `json:"trackingPeriodType,omitempty"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (g *Goal) RawJSON() json.RawMessage {
+	return g.raw
+}
+
+// SetRaw sets the raw JSON response.
+func (g *Goal) SetRaw(data json.RawMessage) {
+	g.raw = data
+}
+
+// Start returns the goal start date.
+func (g *Goal) Start() time.Time {
+	t, _ := time.Parse("2006-01-02", g.StartDate)
+	return t
+}
+
+// End returns the goal end date, or the zero time if the goal is open-ended.
+func (g *Goal) End() time.Time {
+	if g.EndDate == nil {
+		return time.Time{}
+	}
+	t, _ := time.Parse("2006-01-02", *g.EndDate)
+	return t
+}
+
+// GoalList represents a list of goals.
+type GoalList struct {
+	Items []Goal
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (g *GoalList) RawJSON() json.RawMessage {
+	return g.raw
+}
+
+// SetRaw sets the raw JSON response.
+func (g *GoalList) SetRaw(data json.RawMessage) {
+	g.raw = data
+}
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (g *GoalList) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &g.Items)
+}
+
+// GoalProgress represents the progress of a goal computed from daily data.
+type GoalProgress struct {
+	Goal     Goal    `json:"goal"`
+	From     string  `json:"from"`
+	Until    string  `json:"until"`
+	Start    float64 `json:"start"` // value at the beginning of the goal (weight goals only)
+	Current  float64 `json:"current"`
+	Target   float64 `json:"target"`
+	Percent  float64 `json:"percent"` // 0-100
+	Achieved bool    `json:"achieved"`
+}
+
+// List retrieves goals with the given status.
+func (s *GoalService) List(ctx context.Context, status GoalStatus) (*GoalList, error) {
+	path := fmt.Sprintf("/goal-service/goal/goals?status=%s", status)
+	return fetch[GoalList](ctx, s.client, path)
+}
+
+// Create creates a new goal.
+func (s *GoalService) Create(ctx context.Context, goal *Goal) (*Goal, error) {
+	return send[Goal](ctx, s.client, http.MethodPost, "/goal-service/goal/goal", goal)
+}
+
+// Update updates an existing goal.
+func (s *GoalService) Update(ctx context.Context, goalID int64, goal *Goal) (*Goal, error) {
+	path := fmt.Sprintf("/goal-service/goal/goal/%d", goalID)
+	return send[Goal](ctx, s.client, http.MethodPut, path, goal)
+}
+
+// Delete deletes a goal.
+func (s *GoalService) Delete(ctx context.Context, goalID int64) error {
+	path := fmt.Sprintf("/goal-service/goal/goal/%d", goalID)
+	return sendEmpty(ctx, s.client, http.MethodDelete, path)
+}
+
+// GetProgress computes the progress of a goal up to the given date.
+// Weight goals are compared against weigh-ins, distance, duration and activity
+// count goals against the activities recorded since the goal started, and daily
+// steps goals against the steps of the last day of the goal range.
+func (s *GoalService) GetProgress(ctx context.Context, goal *Goal, date time.Time) (*GoalProgress, error) {
+	from := goal.Start()
+	if from.IsZero() {
+		return nil, errors.New("goal has no start date")
+	}
+	until := date
+	if end := goal.End(); !end.IsZero() && end.Before(until) {
+		until = end
+	}
+
+	switch goal.GoalType {
+	case GoalTypeWeight:
+		weights, err := s.client.Weight.GetRange(ctx, from, until)
+		if err != nil {
+			return nil, err
+		}
+		progress := weightGoalProgress(goal, weights)
+		progress.From, progress.Until = from.Format("2006-01-02"), until.Format("2006-01-02")
+		return progress, nil
+	case GoalTypeDistance, GoalTypeDuration, GoalTypeActivities:
+		activities, err := s.activitiesBetween(ctx, from, until)
+		if err != nil {
+			return nil, err
+		}
+		progress := activityGoalProgress(goal, activities)
+		progress.From, progress.Until = from.Format("2006-01-02"), until.Format("2006-01-02")
+		return progress, nil
+	case GoalTypeSteps:
+		steps, err := s.stepsOn(ctx, until)
+		if err != nil {
+			return nil, err
+		}
+		progress := stepsGoalProgress(goal, steps)
+		progress.From, progress.Until = until.Format("2006-01-02"), until.Format("2006-01-02")
+		return progress, nil
+	default:
+		return nil, fmt.Errorf("progress not supported for goal type %q", goal.GoalType)
+	}
+}
+
+// stepsOn returns the number of steps recorded on the given date.
+func (s *GoalService) stepsOn(ctx context.Context, date time.Time) (int, error) {
+	days, err := s.client.Steps.GetDaily(ctx, date, date)
+	if err != nil {
+		return 0, err
+	}
+	if len(days.Items) == 0 || days.Items[0].TotalSteps == nil {
+		return 0, nil
+	}
+	return *days.Items[0].TotalSteps, nil
+}
+
+// activitiesBetween pages through the activity list (most recent first) and
+// returns the activities started between from and until (inclusive dates).
+func (s *GoalService) activitiesBetween(ctx context.Context, from, until time.Time) ([]Activity, error) {
+	fromDate := from.Format("2006-01-02")
+	untilDate := until.Format("2006-01-02")
+
+	var result []Activity
+	for start := 0; ; start += goalActivityPageSize {
+		page, err := s.client.Activities.List(ctx, &ListOptions{Start: start, Limit: goalActivityPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for i := range page {
+			day := activityDate(&page[i])
+			if day < fromDate {
+				return result, nil
+			}
+			if day <= untilDate {
+				result = append(result, page[i])
+			}
+		}
+		if len(page) < goalActivityPageSize {
+			return result, nil
+		}
+	}
+}
+
+// activityDate returns the local start date (YYYY-MM-DD) of an activity.
+func activityDate(a *Activity) string {
+	if len(a.StartTimeLocal) < 10 {
+		return a.StartTimeLocal
+	}
+	return a.StartTimeLocal[:10]
+}
+
+// weightGoalProgress computes the progress of a weight goal from weigh-ins.
+// Progress is measured from the first weigh-in of the period toward the target.
+func weightGoalProgress(goal *Goal, weights *WeightRange) *GoalProgress {
+	progress := &GoalProgress{Goal: *goal, Target: goal.GoalValue}
+
+	var entries []WeightEntry
+	for _, d := range weights.DailyWeightSummaries {
+		for _, m := range d.AllWeightMetrics {
+			if m.Weight != nil && m.Date != nil {
+				entries = append(entries, m)
+			}
+		}
+	}
+	if len(entries) == 0 {
+		return progress
+	}
+
+	first, last := entries[0], entries[0]
+	for _, e := range entries[1:] {
+		if *e.Date < *first.Date {
+			first = e
+		}
+		if *e.Date > *last.Date {
+			last = e
+		}
+	}
+	progress.Start = *first.Weight
+	progress.Current = *last.Weight
+
+	total := progress.Start - progress.Target
+	done := progress.Start - progress.Current
+	switch {
+	case total == 0:
+		progress.Percent = 100
+	default:
+		progress.Percent = clampPercent(done / total * 100)
+	}
+	progress.Achieved = progress.Percent >= 100
+
+	return progress
+}
+
+// activityGoalProgress computes the progress of a distance, duration or activity
+// count goal from the activities recorded during the goal period.
+func activityGoalProgress(goal *Goal, activities []Activity) *GoalProgress {
+	progress := &GoalProgress{Goal: *goal, Target: goal.GoalValue}
+
+	for i := range activities {
+		a := &activities[i]
+		if goal.ActivityTypePK != nil &&
+			a.ActivityType.TypeID != *goal.ActivityTypePK &&
+			a.ActivityType.ParentTypeID != *goal.ActivityTypePK {
+			continue
+		}
+		switch goal.GoalType {
+		case GoalTypeDistance:
+			progress.Current += a.Distance
+		case GoalTypeDuration:
+			progress.Current += a.Duration
+		case GoalTypeActivities:
+			progress.Current++
+		}
+	}
+
+	if progress.Target > 0 {
+		progress.Percent = clampPercent(progress.Current / progress.Target * 100)
+	}
+	progress.Achieved = progress.Target > 0 && progress.Current >= progress.Target
+
+	return progress
+}
+
+// stepsGoalProgress computes the progress of a daily steps goal from the steps of a day.
+func stepsGoalProgress(goal *Goal, steps int) *GoalProgress {
+	progress := &GoalProgress{Goal: *goal, Target: goal.GoalValue, Current: float64(steps)}
+
+	if progress.Target > 0 {
+		progress.Percent = clampPercent(progress.Current / progress.Target * 100)
+	}
+	progress.Achieved = progress.Target > 0 && progress.Current >= progress.Target
+
+	return progress
+}
+
+// clampPercent bounds a percentage to the 0-100 range.
+func clampPercent(p float64) float64 {
+	return max(0, min(100, p))
+}
@@ -0,0 +1,181 @@
+// service_goal_test.go
+package garmin
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestGoalListJSONUnmarshal(t *testing.T) {
+	rawJSON := `[
+		{
+			"id": 35853458,
+			"userProfilePk": 12345678,
+			"goalType": "weight",
+			"goalName": "Race weight",
+			"goalValue": 72000.0,
+			"startDate": "2026-01-01",
+			"endDate": "2026-06-30",
+			"createDate": "2026-01-01T09:00:00.0",
+			"activityTypePk": null,
+			"trackingPeriodType": null
+		},
+		{
+			"id": 35853459,
+			"userProfilePk": 12345678,
+			"goalType": "distance",
+			"goalValue": 100000.0,
+			"startDate": "2026-01-01",
+			"endDate": null,
+			"activityTypePk": 1
+		}
+	]`
+
+	var goals GoalList
+	if err := json.Unmarshal([]byte(rawJSON), &goals); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if len(goals.Items) != 2 {
+		t.Fatalf("len(Items) = %d, want 2", len(goals.Items))
+	}
+
+	weight := goals.Items[0]
+	if weight.ID != 35853458 {
+		t.Errorf("ID = %d, want 35853458", weight.ID)
+	}
+	if weight.GoalType != GoalTypeWeight {
+		t.Errorf("GoalType = %s, want %s", weight.GoalType, GoalTypeWeight)
+	}
+	if weight.End().IsZero() || weight.End().Format("2006-01-02") != "2026-06-30" {
+		t.Errorf("End() = %v, want 2026-06-30", weight.End())
+	}
+
+	distance := goals.Items[1]
+	if distance.ActivityTypePK == nil || *distance.ActivityTypePK != 1 {
+		t.Errorf("ActivityTypePK = %v, want 1", distance.ActivityTypePK)
+	}
+	if !distance.End().IsZero() {
+		t.Errorf("End() = %v, want zero time for open-ended goal", distance.End())
+	}
+}
+
+func TestGoalMarshalOmitsEmptyFields(t *testing.T) {
+	goal := Goal{GoalType: GoalTypeSteps, GoalValue: 10000, StartDate: "2026-01-01"}
+
+	data, err := json.Marshal(goal)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"goalType":"steps","goalValue":10000,"startDate":"2026-01-01"}`
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+}
+
+func TestWeightGoalProgress(t *testing.T) {
+	rawJSON := `{
+		"dailyWeightSummaries": [
+			{"summaryDate": "2026-01-20", "allWeightMetrics": [{"date": 1768910400000, "weight": 76000.0}]},
+			{"summaryDate": "2026-01-01", "allWeightMetrics": [{"date": 1767268800000, "weight": 80000.0}]}
+		]
+	}`
+
+	var weights WeightRange
+	if err := json.Unmarshal([]byte(rawJSON), &weights); err != nil {
+		t.Fatal(err)
+	}
+
+	goal := &Goal{GoalType: GoalTypeWeight, GoalValue: 72000, StartDate: "2026-01-01"}
+	progress := weightGoalProgress(goal, &weights)
+
+	if progress.Start != 80000 {
+		t.Errorf("Start = %f, want 80000", progress.Start)
+	}
+	if progress.Current != 76000 {
+		t.Errorf("Current = %f, want 76000", progress.Current)
+	}
+	if math.Abs(progress.Percent-50) > 0.001 {
+		t.Errorf("Percent = %f, want 50", progress.Percent)
+	}
+	if progress.Achieved {
+		t.Error("expected goal not to be achieved")
+	}
+}
+
+func TestWeightGoalProgressNoData(t *testing.T) {
+	goal := &Goal{GoalType: GoalTypeWeight, GoalValue: 72000, StartDate: "2026-01-01"}
+	progress := weightGoalProgress(goal, &WeightRange{})
+
+	if progress.Current != 0 || progress.Percent != 0 {
+		t.Errorf("progress = %+v, want empty progress", progress)
+	}
+}
+
+func TestActivityGoalProgress(t *testing.T) {
+	activities := []Activity{
+		{StartTimeLocal: "2026-01-03 07:00:00", ActivityType: ActivityType{TypeID: 1}, Distance: 10000, Duration: 3000},
+		{StartTimeLocal: "2026-01-05 07:00:00", ActivityType: ActivityType{TypeID: 7, ParentTypeID: 1}, Distance: 8000, Duration: 2400},
+		{StartTimeLocal: "2026-01-06 18:00:00", ActivityType: ActivityType{TypeID: 2}, Distance: 40000, Duration: 5400},
+	}
+	running := 1
+
+	tests := []struct {
+		name        string
+		goal        Goal
+		wantCurrent float64
+		wantPercent float64
+		achieved    bool
+	}{
+		{"running distance", Goal{GoalType: GoalTypeDistance, GoalValue: 36000, ActivityTypePK: &running}, 18000, 50, false},
+		{"all distance", Goal{GoalType: GoalTypeDistance, GoalValue: 50000}, 58000, 100, true},
+		{"duration", Goal{GoalType: GoalTypeDuration, GoalValue: 10800}, 10800, 100, true},
+		{"activity count", Goal{GoalType: GoalTypeActivities, GoalValue: 4}, 3, 75, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress := activityGoalProgress(&tt.goal, activities)
+			if progress.Current != tt.wantCurrent {
+				t.Errorf("Current = %f, want %f", progress.Current, tt.wantCurrent)
+			}
+			if math.Abs(progress.Percent-tt.wantPercent) > 0.001 {
+				t.Errorf("Percent = %f, want %f", progress.Percent, tt.wantPercent)
+			}
+			if progress.Achieved != tt.achieved {
+				t.Errorf("Achieved = %v, want %v", progress.Achieved, tt.achieved)
+			}
+		})
+	}
+}
+
+func TestGoalRawJSON(t *testing.T) {
+	rawJSON := `{"id":1,"goalType":"steps","goalValue":10000}`
+
+	var goal Goal
+	if err := json.Unmarshal([]byte(rawJSON), &goal); err != nil {
+		t.Fatal(err)
+	}
+	goal.SetRaw(json.RawMessage(rawJSON))
+
+	if string(goal.RawJSON()) != rawJSON {
+		t.Error("RawJSON should return original JSON")
+	}
+}
+
+func TestStepsGoalProgress(t *testing.T) {
+	goal := &Goal{GoalType: GoalTypeSteps, GoalValue: 10000}
+
+	progress := stepsGoalProgress(goal, 7500)
+	if progress.Current != 7500 {
+		t.Errorf("Current = %f, want 7500", progress.Current)
+	}
+	if math.Abs(progress.Percent-75) > 0.001 {
+		t.Errorf("Percent = %f, want 75", progress.Percent)
+	}
+	if progress.Achieved {
+		t.Error("expected goal not to be achieved")
+	}
+}
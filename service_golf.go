@@ -0,0 +1,256 @@
+// service_golf.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// golfPageSize is the page size used when iterating over scorecards.
+const golfPageSize = 20
+
+// Fairway shot outcomes of a golf hole.
+const (
+	FairwayHit   = "HIT"
+	FairwayLeft  = "LEFT"
+	FairwayRight = "RIGHT"
+)
+
+// GolfScorecardSummary represents a golf round in the scorecard list.
+type GolfScorecardSummary struct {
+	ID                   int64   `json:"id"`
+	CourseName           string  `json:"courseName"`
+	CourseSnapshotID     *int64  `json:"courseSnapshotId"`
+	TeeBox               *string `json:"teeBox"`
+	StartTime            string  `json:"startTime"`
+	EndTime              *string `json:"endTime"`
+	Strokes              int     `json:"strokes"`
+	HandicappedStrokes   *int    `json:"handicappedStrokes"`
+	ScoreWithHandicap    *int    `json:"scoreWithHandicap"`
+	ScoreWithoutHandicap *int    `json:"scoreWithoutHandicap"`
+	HolesCompleted       int     `json:"holesCompleted"`
+	RoundType            *string `json:"roundType"` // e.g. "ALL", "FRONT_NINE"
+	ActivityID           *int64  `json:"activityId"`
+}
+
+// GolfScorecardList represents a page of golf scorecard summaries.
+type GolfScorecardList struct {
+	PageNumber         int                    `json:"pageNumber"`
+	RowsPerPage        int                    `json:"rowsPerPage"`
+	TotalRows          int                    `json:"totalRows"`
+	ScorecardSummaries []GolfScorecardSummary `json:"scorecardSummaries"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (g *GolfScorecardList) RawJSON() json.RawMessage { return g.raw }
+
+// SetRaw sets the raw JSON response.
+func (g *GolfScorecardList) SetRaw(data json.RawMessage) { g.raw = data }
+
+// GolfHole represents the score of a hole.
+type GolfHole struct {
+	Number             int     `json:"number"`
+	Strokes            int     `json:"strokes"`
+	Putts              *int    `json:"putts"`
+	Penalties          *int    `json:"penalties"`
+	FairwayShotOutcome *string `json:"fairwayShotOutcome"` // FairwayHit, FairwayLeft or FairwayRight
+	HandicapScore      *int    `json:"handicapScore"`
+}
+
+// GolfScorecard represents a golf round with its per-hole scores.
+type GolfScorecard struct {
+	ID               int64      `json:"id"`
+	CourseSnapshotID *int64     `json:"courseSnapshotId"`
+	TeeBox           *string    `json:"teeBox"`
+	StartTime        string     `json:"startTime"`
+	EndTime          *string    `json:"endTime"`
+	Strokes          int        `json:"strokes"`
+	HolesCompleted   int        `json:"holesCompleted"`
+	RoundType        *string    `json:"roundType"`
+	ScoreType        *string    `json:"scoreType"` // e.g. "STROKE_PLAY"
+	ActivityID       *int64     `json:"activityId"`
+	Holes            []GolfHole `json:"holes"`
+}
+
+// GolfCourseSnapshot describes the course a round was played on.
+type GolfCourseSnapshot struct {
+	CourseSnapshotID int64   `json:"courseSnapshotId"`
+	CourseName       string  `json:"name"`
+	Tees             *string `json:"tees"`
+	HolePars         string  `json:"holePars"` // one digit per hole, e.g. "443545344"
+	Rating           *int    `json:"rating"`
+	Slope            *int    `json:"slope"`
+}
+
+// Par returns the par of a hole (1-based), or 0 if unknown.
+func (g *GolfCourseSnapshot) Par(hole int) int {
+	if hole < 1 || hole > len(g.HolePars) {
+		return 0
+	}
+	par, err := strconv.Atoi(g.HolePars[hole-1 : hole])
+	if err != nil {
+		return 0
+	}
+	return par
+}
+
+// GolfScorecardDetail represents a golf round with the course it was played on.
+type GolfScorecardDetail struct {
+	Scorecard      GolfScorecard       `json:"scorecard"`
+	CourseSnapshot *GolfCourseSnapshot `json:"courseSnapshot"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (g *GolfScorecardDetail) RawJSON() json.RawMessage { return g.raw }
+
+// SetRaw sets the raw JSON response.
+func (g *GolfScorecardDetail) SetRaw(data json.RawMessage) { g.raw = data }
+
+// GolfScorecardStats are aggregates computed from the holes of a scorecard.
+// Percentages are nil when no hole has the information.
+type GolfScorecardStats struct {
+	HolesPlayed           int      `json:"holesPlayed"`
+	Strokes               int      `json:"strokes"`
+	Par                   int      `json:"par"`        // par of the holes played, 0 if unknown
+	ScoreToPar            *int     `json:"scoreToPar"` // nil if par is unknown
+	Putts                 int      `json:"putts"`
+	AveragePutts          *float64 `json:"averagePutts"`
+	GreensInRegulation    int      `json:"greensInRegulation"`
+	GreensInRegulationPct *float64 `json:"greensInRegulationPct"`
+	FairwaysHit           int      `json:"fairwaysHit"`
+	FairwayAttempts       int      `json:"fairwayAttempts"`
+	FairwaysHitPct        *float64 `json:"fairwaysHitPct"`
+	Penalties             int      `json:"penalties"`
+}
+
+// Stats computes the aggregates of the scorecard. A green is hit in regulation
+// when the ball is on the green in par minus two strokes; fairways are only
+// counted on holes with a recorded tee shot outcome.
+func (g *GolfScorecardDetail) Stats() GolfScorecardStats {
+	var st GolfScorecardStats
+	var puttHoles, girHoles int // holes with putts recorded, and with a known par
+	parKnown := g.CourseSnapshot != nil
+	for _, hole := range g.Scorecard.Holes {
+		if hole.Strokes == 0 {
+			continue // not played
+		}
+		st.HolesPlayed++
+		st.Strokes += hole.Strokes
+
+		par := 0
+		if g.CourseSnapshot != nil {
+			par = g.CourseSnapshot.Par(hole.Number)
+		}
+		if par == 0 {
+			parKnown = false
+		}
+		st.Par += par
+
+		if hole.Penalties != nil {
+			st.Penalties += *hole.Penalties
+		}
+		if hole.Putts != nil {
+			st.Putts += *hole.Putts
+			puttHoles++
+			if par != 0 {
+				girHoles++
+				if hole.Strokes-*hole.Putts <= par-2 {
+					st.GreensInRegulation++
+				}
+			}
+		}
+		if hole.FairwayShotOutcome != nil {
+			st.FairwayAttempts++
+			if *hole.FairwayShotOutcome == FairwayHit {
+				st.FairwaysHit++
+			}
+		}
+	}
+
+	if parKnown && st.HolesPlayed > 0 {
+		score := st.Strokes - st.Par
+		st.ScoreToPar = &score
+	} else {
+		st.Par = 0
+	}
+	st.AveragePutts = golfRatio(float64(st.Putts), puttHoles, 1)
+	st.GreensInRegulationPct = golfRatio(float64(st.GreensInRegulation), girHoles, 100)
+	st.FairwaysHitPct = golfRatio(float64(st.FairwaysHit), st.FairwayAttempts, 100)
+	return st
+}
+
+// golfRatio returns value/count*scale, or nil if count is zero.
+func golfRatio(value float64, count int, scale float64) *float64 {
+	if count == 0 {
+		return nil
+	}
+	r := value / float64(count) * scale
+	return &r
+}
+
+// golfScorecardDetails is the response of the scorecard detail endpoint.
+type golfScorecardDetails struct {
+	ScorecardDetails []struct {
+		Scorecard       GolfScorecard        `json:"scorecard"`
+		CourseSnapshots []GolfCourseSnapshot `json:"courseSnapshots"`
+	} `json:"scorecardDetails"`
+
+	raw json.RawMessage
+}
+
+// SetRaw sets the raw JSON response.
+func (g *golfScorecardDetails) SetRaw(data json.RawMessage) { g.raw = data }
+
+// ListScorecards retrieves a page (1-based) of golf scorecard summaries, most recent first.
+func (s *GolfService) ListScorecards(ctx context.Context, page, perPage int) (*GolfScorecardList, error) {
+	params := url.Values{}
+	params.Set("page", strconv.Itoa(page))
+	params.Set("per-page", strconv.Itoa(perPage))
+	params.Set("user-locale", "en")
+	return fetch[GolfScorecardList](ctx, s.client, "/gcs-golfcommunity/api/v2/scorecard/summary?"+params.Encode())
+}
+
+// Scorecards iterates over all golf scorecard summaries, most recent first.
+func (s *GolfService) Scorecards(ctx context.Context) iter.Seq2[GolfScorecardSummary, error] {
+	return paginate(ctx, 0, golfPageSize, func(ctx context.Context, start, limit int) ([]GolfScorecardSummary, error) {
+		list, err := s.ListScorecards(ctx, start/limit+1, limit)
+		if err != nil {
+			return nil, err
+		}
+		return list.ScorecardSummaries, nil
+	})
+}
+
+// GetScorecard retrieves a golf scorecard with its per-hole scores and course.
+// Returns ErrNotFound if the scorecard does not exist.
+func (s *GolfService) GetScorecard(ctx context.Context, scorecardID int64) (*GolfScorecardDetail, error) {
+	path := fmt.Sprintf("/gcs-golfcommunity/api/v2/scorecard/detail?scorecard-ids=%d&include-longest-shot-distance=true", scorecardID)
+	details, err := fetch[golfScorecardDetails](ctx, s.client, path)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range details.ScorecardDetails {
+		if d.Scorecard.ID != scorecardID {
+			continue
+		}
+		detail := &GolfScorecardDetail{Scorecard: d.Scorecard, raw: details.raw}
+		for i := range d.CourseSnapshots {
+			if d.Scorecard.CourseSnapshotID != nil && d.CourseSnapshots[i].CourseSnapshotID == *d.Scorecard.CourseSnapshotID {
+				detail.CourseSnapshot = &d.CourseSnapshots[i]
+			}
+		}
+		if detail.CourseSnapshot == nil && len(d.CourseSnapshots) == 1 {
+			detail.CourseSnapshot = &d.CourseSnapshots[0]
+		}
+		return detail, nil
+	}
+	return nil, fmt.Errorf("golf scorecard %d: %w", scorecardID, ErrNotFound)
+}
@@ -0,0 +1,123 @@
+// service_golf_test.go
+package garmin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+const testScorecardDetailJSON = `{"scorecardDetails": [{
+	"scorecard": {"id": 42, "courseSnapshotId": 7, "startTime": "2026-01-24T09:12:00.000Z",
+		"strokes": 18, "holesCompleted": 4, "holes": [
+			{"number": 1, "strokes": 4, "putts": 2, "fairwayShotOutcome": "HIT", "penalties": 0},
+			{"number": 2, "strokes": 5, "putts": 1, "fairwayShotOutcome": "LEFT", "penalties": 1},
+			{"number": 3, "strokes": 3, "putts": 2},
+			{"number": 4, "strokes": 6, "putts": 3, "fairwayShotOutcome": "HIT"},
+			{"number": 5, "strokes": 0}
+		]},
+	"courseSnapshots": [{"courseSnapshotId": 7, "name": "Test Links", "holePars": "443545344"}]
+}]}`
+
+func TestGolfScorecardStats(t *testing.T) {
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		return http.StatusOK, []byte(testScorecardDetailJSON)
+	})
+
+	detail, err := client.Golf.GetScorecard(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("GetScorecard failed: %v", err)
+	}
+	if detail.CourseSnapshot == nil || detail.CourseSnapshot.CourseName != "Test Links" {
+		t.Fatalf("CourseSnapshot = %+v, want Test Links", detail.CourseSnapshot)
+	}
+	if detail.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+
+	st := detail.Stats()
+	if st.HolesPlayed != 4 || st.Strokes != 18 || st.Par != 16 {
+		t.Errorf("HolesPlayed/Strokes/Par = %d/%d/%d, want 4/18/16", st.HolesPlayed, st.Strokes, st.Par)
+	}
+	if st.ScoreToPar == nil || *st.ScoreToPar != 2 {
+		t.Errorf("ScoreToPar = %v, want 2", st.ScoreToPar)
+	}
+	if st.Putts != 8 || st.AveragePutts == nil || *st.AveragePutts != 2 {
+		t.Errorf("Putts = %d (avg %v), want 8 (avg 2)", st.Putts, st.AveragePutts)
+	}
+	// Only hole 2 (on the green in 4 on a par 4) misses the green in regulation
+	if st.GreensInRegulation != 3 || st.GreensInRegulationPct == nil || *st.GreensInRegulationPct != 75 {
+		t.Errorf("GreensInRegulation = %d (%v%%), want 3 (75%%)", st.GreensInRegulation, st.GreensInRegulationPct)
+	}
+	if st.FairwaysHit != 2 || st.FairwayAttempts != 3 {
+		t.Errorf("Fairways = %d/%d, want 2/3", st.FairwaysHit, st.FairwayAttempts)
+	}
+	if st.Penalties != 1 {
+		t.Errorf("Penalties = %d, want 1", st.Penalties)
+	}
+}
+
+func TestGolfScorecardStatsWithoutCourse(t *testing.T) {
+	putts := 2
+	detail := &GolfScorecardDetail{Scorecard: GolfScorecard{Holes: []GolfHole{{Number: 1, Strokes: 4, Putts: &putts}}}}
+
+	st := detail.Stats()
+	if st.Par != 0 || st.ScoreToPar != nil {
+		t.Errorf("Par/ScoreToPar = %d/%v, want unknown", st.Par, st.ScoreToPar)
+	}
+	if st.GreensInRegulationPct != nil || st.FairwaysHitPct != nil {
+		t.Error("expected percentages to be nil without par or tee shots")
+	}
+	if st.AveragePutts == nil || *st.AveragePutts != 2 {
+		t.Errorf("AveragePutts = %v, want 2", st.AveragePutts)
+	}
+}
+
+func TestGolfGetScorecardNotFound(t *testing.T) {
+	client := newFakeClient(t, func(*http.Request) (int, []byte) {
+		return http.StatusOK, []byte(`{"scorecardDetails": []}`)
+	})
+
+	if _, err := client.Golf.GetScorecard(context.Background(), 42); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetScorecard error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestGolfScorecardsPagination(t *testing.T) {
+	var pages []string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		page := req.URL.Query().Get("page")
+		pages = append(pages, page)
+		if req.URL.Query().Get("per-page") != strconv.Itoa(golfPageSize) {
+			t.Errorf("per-page = %s, want %d", req.URL.Query().Get("per-page"), golfPageSize)
+		}
+		count := golfPageSize
+		if page == "2" {
+			count = 3
+		}
+		body := `{"pageNumber": ` + page + `, "scorecardSummaries": [`
+		for i := range count {
+			if i > 0 {
+				body += ","
+			}
+			body += `{"id": ` + strconv.Itoa(i+1) + `}`
+		}
+		return http.StatusOK, []byte(body + `]}`)
+	})
+
+	var n int
+	for _, err := range client.Golf.Scorecards(context.Background()) {
+		if err != nil {
+			t.Fatalf("Scorecards failed: %v", err)
+		}
+		n++
+	}
+	if n != golfPageSize+3 {
+		t.Errorf("got %d scorecards, want %d", n, golfPageSize+3)
+	}
+	if len(pages) != 2 || pages[0] != "1" || pages[1] != "2" {
+		t.Errorf("requested pages %v, want [1 2]", pages)
+	}
+}
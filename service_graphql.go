@@ -0,0 +1,254 @@
+// service_graphql.go
+package garmin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// graphQLPath is the path of the GraphQL gateway.
+const graphQLPath = "/graphql-gateway/graphql"
+
+// GraphQLError is an error reported in the errors array of a GraphQL response.
+type GraphQLError struct {
+	Message    string         `json:"message"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// graphQLRequest is the request body of a GraphQL query.
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// graphQLResponse is the response body of a GraphQL query.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []GraphQLError  `json:"errors"`
+}
+
+// GraphQLArg is a literal argument of a GraphQL field. Value is a string, bool,
+// number, []string or time.Time (sent as a YYYY-MM-DD date).
+type GraphQLArg struct {
+	Name  string
+	Value any
+}
+
+// graphQLField is a field of a GraphQL query.
+type graphQLField struct {
+	alias string
+	name  string
+	args  []GraphQLArg
+}
+
+// GraphQLQuery builds a GraphQL query. The gateway exposes most data as scalar
+// fields (e.g. "sleepSummariesScalar") that return JSON and take literal arguments.
+type GraphQLQuery struct {
+	fields []graphQLField
+}
+
+// Field adds a field to the query. Its data is returned under alias, or under the
+// field name if alias is empty.
+func (q *GraphQLQuery) Field(alias, name string, args ...GraphQLArg) *GraphQLQuery {
+	q.fields = append(q.fields, graphQLField{alias: alias, name: name, args: args})
+	return q
+}
+
+// String returns the text of the query.
+func (q *GraphQLQuery) String() string {
+	var b strings.Builder
+	b.WriteString("query{")
+	for i, f := range q.fields {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		if f.alias != "" {
+			b.WriteString(f.alias + ":")
+		}
+		b.WriteString(f.name)
+		if len(f.args) > 0 {
+			b.WriteByte('(')
+			for j, arg := range f.args {
+				if j > 0 {
+					b.WriteByte(',')
+				}
+				b.WriteString(arg.Name + ":" + graphQLValue(arg.Value))
+			}
+			b.WriteByte(')')
+		}
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// graphQLValue formats a literal argument value. JSON scalars and arrays of
+// scalars are valid GraphQL literals.
+func graphQLValue(v any) string {
+	if t, ok := v.(time.Time); ok {
+		v = t.Format("2006-01-02")
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "null"
+	}
+	return string(data)
+}
+
+// Query runs a GraphQL query and unmarshals its data into out, which may be nil to
+// discard it. Errors reported by the gateway are returned as an APIError whose
+// Message joins the error messages and whose Body is the errors array; data
+// returned along with errors is still unmarshaled into out.
+func (s *GraphQLService) Query(ctx context.Context, query string, vars map[string]any, out any) error {
+	data, err := s.query(ctx, query, vars)
+	if len(data) > 0 && !bytes.Equal(data, []byte("null")) && out != nil {
+		if uerr := json.Unmarshal(data, out); uerr != nil && err == nil {
+			return fmt.Errorf("unmarshal graphql data: %w", uerr)
+		}
+	}
+	return err
+}
+
+// query runs a GraphQL query and returns its raw data.
+func (s *GraphQLService) query(ctx context.Context, query string, vars map[string]any) (json.RawMessage, error) {
+	payload, err := json.Marshal(&graphQLRequest{Query: query, Variables: vars})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := s.client.doAPIWithBody(ctx, http.MethodPost, graphQLPath, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result graphQLResponse
+	if jerr := json.Unmarshal(raw, &result); jerr != nil {
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return nil, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Endpoint: graphQLPath, Body: raw}
+		}
+		return nil, fmt.Errorf("unmarshal graphql response: %w", jerr)
+	}
+
+	if len(result.Errors) > 0 {
+		messages := make([]string, len(result.Errors))
+		for i, e := range result.Errors {
+			messages[i] = e.Message
+		}
+		body, _ := json.Marshal(result.Errors)
+		return result.Data, &APIError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Endpoint:   graphQLPath,
+			Message:    strings.Join(messages, "; "),
+			Body:       body,
+		}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Endpoint: graphQLPath, Body: raw}
+	}
+	return result.Data, nil
+}
+
+// HealthDashboard is the health overview of a date range, fetched in a single
+// GraphQL request. Summaries without a REST counterpart are kept as raw JSON.
+type HealthDashboard struct {
+	HRV            *HRVRange           `json:"hrv"`
+	Weight         *WeightRange        `json:"weight"`
+	BloodPressure  *BloodPressureRange `json:"bloodPressure"`
+	SleepSummaries json.RawMessage     `json:"sleepSummaries"`
+	DailySummaries json.RawMessage     `json:"dailySummaries"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON data.
+func (h *HealthDashboard) RawJSON() json.RawMessage { return h.raw }
+
+// SetRaw sets the raw JSON data.
+func (h *HealthDashboard) SetRaw(data json.RawMessage) { h.raw = data }
+
+// TrainingDashboard is the training overview of a date range, fetched in a single
+// GraphQL request. Summaries without a REST counterpart are kept as raw JSON.
+type TrainingDashboard struct {
+	TrainingStatus    *TrainingStatusDaily `json:"trainingStatus"` // on the end date
+	TrainingReadiness json.RawMessage      `json:"trainingReadiness"`
+	EnduranceScore    json.RawMessage      `json:"enduranceScore"` // weekly
+	VO2Max            json.RawMessage      `json:"vo2Max"`
+	ScheduledWorkouts json.RawMessage      `json:"scheduledWorkouts"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON data.
+func (t *TrainingDashboard) RawJSON() json.RawMessage { return t.raw }
+
+// SetRaw sets the raw JSON data.
+func (t *TrainingDashboard) SetRaw(data json.RawMessage) { t.raw = data }
+
+// HealthDashboardQuery returns the query of GetHealthDashboard.
+func HealthDashboardQuery(start, end time.Time) *GraphQLQuery {
+	dates := []GraphQLArg{{"startDate", start}, {"endDate", end}}
+	return new(GraphQLQuery).
+		Field("hrv", "heartRateVariabilityScalar", dates...).
+		Field("weight", "weightScalar", dates...).
+		Field("bloodPressure", "bloodPressureScalar", dates...).
+		Field("sleepSummaries", "sleepSummariesScalar", dates...).
+		Field("dailySummaries", "userDailySummaryV2Scalar", dates...)
+}
+
+// TrainingDashboardQuery returns the query of GetTrainingDashboard.
+func TrainingDashboardQuery(start, end time.Time) *GraphQLQuery {
+	dates := []GraphQLArg{{"startDate", start}, {"endDate", end}}
+	return new(GraphQLQuery).
+		Field("trainingStatus", "trainingStatusDailyScalar", GraphQLArg{"calendarDate", end}).
+		Field("trainingReadiness", "trainingReadinessRangeScalar", dates...).
+		Field("enduranceScore", "enduranceScoreScalar", append(dates, GraphQLArg{"aggregation", "weekly"})...).
+		Field("vo2Max", "vo2MaxScalar", dates...).
+		Field("scheduledWorkouts", "workoutScheduleSummariesScalar", dates...)
+}
+
+// GetHealthDashboard retrieves HRV, weight, blood pressure, sleep and daily
+// summaries between two dates in a single request.
+func (s *GraphQLService) GetHealthDashboard(ctx context.Context, start, end time.Time) (*HealthDashboard, error) {
+	return graphQLFetch[HealthDashboard](ctx, s, HealthDashboardQuery(start, end))
+}
+
+// GetTrainingDashboard retrieves the training status, training readiness,
+// endurance score, VO2 max and scheduled workouts between two dates in a single
+// request.
+func (s *GraphQLService) GetTrainingDashboard(ctx context.Context, start, end time.Time) (*TrainingDashboard, error) {
+	return graphQLFetch[TrainingDashboard](ctx, s, TrainingDashboardQuery(start, end))
+}
+
+// graphQLFetch runs a pre-written query and unmarshals its data into T.
+// Returns ErrNotFound if the response has no data.
+func graphQLFetch[T any, PT interface {
+	*T
+	RawSetter
+}](ctx context.Context, s *GraphQLService, q *GraphQLQuery) (*T, error) {
+	data, err := s.query(ctx, q.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, ErrNotFound
+	}
+	result := new(T)
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, fmt.Errorf("unmarshal graphql data: %w", err)
+	}
+	PT(result).SetRaw(data)
+	return result, nil
+}
@@ -0,0 +1,159 @@
+// service_graphql_test.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGraphQLQueryString(t *testing.T) {
+	q := new(GraphQLQuery).
+		Field("sleep", "sleepSummariesScalar",
+			GraphQLArg{"startDate", time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)},
+			GraphQLArg{"endDate", "2026-01-27"}).
+		Field("", "activityStatsScalar",
+			GraphQLArg{"metrics", []string{"duration", "distance"}},
+			GraphQLArg{"standardizedUnits", true},
+			GraphQLArg{"limit", 10}).
+		Field("", "userScalar")
+
+	want := `query{sleep:sleepSummariesScalar(startDate:"2026-01-20",endDate:"2026-01-27") ` +
+		`activityStatsScalar(metrics:["duration","distance"],standardizedUnits:true,limit:10) userScalar}`
+	if got := q.String(); got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestGraphQLQuery(t *testing.T) {
+	var sent graphQLRequest
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.Method != http.MethodPost || req.URL.Path != "/graphql-gateway/graphql" {
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		return http.StatusOK, []byte(`{"data": {"vo2MaxScalar": [{"calendarDate": "2026-01-27", "vo2MaxValue": 52}]}}`)
+	})
+
+	var out struct {
+		VO2Max []struct {
+			CalendarDate string  `json:"calendarDate"`
+			Value        float64 `json:"vo2MaxValue"`
+		} `json:"vo2MaxScalar"`
+	}
+	vars := map[string]any{"date": "2026-01-27"}
+	if err := client.GraphQL.Query(context.Background(), "query{vo2MaxScalar}", vars, &out); err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+
+	if sent.Query != "query{vo2MaxScalar}" || sent.Variables["date"] != "2026-01-27" {
+		t.Errorf("sent = %+v", sent)
+	}
+	if len(out.VO2Max) != 1 || out.VO2Max[0].Value != 52 {
+		t.Errorf("out = %+v, want one VO2 max of 52", out)
+	}
+}
+
+func TestGraphQLQueryErrors(t *testing.T) {
+	client := newFakeClient(t, func(*http.Request) (int, []byte) {
+		return http.StatusOK, []byte(`{
+			"data": {"weightScalar": {"totalAverage": {"weight": 72000}}, "badScalar": null},
+			"errors": [
+				{"message": "Validation error of type FieldUndefined", "path": ["badScalar"]},
+				{"message": "Unknown argument"}
+			]
+		}`)
+	})
+
+	var out map[string]json.RawMessage
+	err := client.GraphQL.Query(context.Background(), "query{weightScalar badScalar}", nil, &out)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Query error = %v, want APIError", err)
+	}
+	if apiErr.Message != "Validation error of type FieldUndefined; Unknown argument" {
+		t.Errorf("Message = %q", apiErr.Message)
+	}
+	if apiErr.Endpoint != "/graphql-gateway/graphql" {
+		t.Errorf("Endpoint = %q", apiErr.Endpoint)
+	}
+	var errs []GraphQLError
+	if err := json.Unmarshal(apiErr.Body, &errs); err != nil || len(errs) != 2 {
+		t.Errorf("Body = %s, want the errors array", apiErr.Body)
+	}
+	// Partial data is still returned
+	if _, ok := out["weightScalar"]; !ok {
+		t.Errorf("out = %v, want partial data", out)
+	}
+}
+
+func TestGraphQLQueryHTTPError(t *testing.T) {
+	client := newFakeClient(t, func(*http.Request) (int, []byte) {
+		return http.StatusBadRequest, []byte(`Bad Request`)
+	})
+
+	err := client.GraphQL.Query(context.Background(), "query{", nil, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Query error = %v, want 400 APIError", err)
+	}
+	if apiErr.Endpoint != graphQLPath {
+		t.Errorf("Endpoint = %q, want %q", apiErr.Endpoint, graphQLPath)
+	}
+}
+
+func TestGetHealthDashboardNullData(t *testing.T) {
+	client := newFakeClient(t, func(*http.Request) (int, []byte) {
+		return http.StatusOK, []byte(`{"data": null}`)
+	})
+
+	_, err := client.GraphQL.GetHealthDashboard(context.Background(), time.Now(), time.Now())
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetHealthDashboard error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestGetHealthDashboard(t *testing.T) {
+	var sent graphQLRequest
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		return http.StatusOK, []byte(`{"data": {
+			"hrv": {"hrvSummaries": [{"calendarDate": "2026-01-27", "lastNightAvg": 48}]},
+			"weight": null,
+			"bloodPressure": null,
+			"sleepSummaries": [{"calendarDate": "2026-01-27"}],
+			"dailySummaries": []
+		}}`)
+	})
+
+	start := time.Date(2026, 1, 21, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+	dashboard, err := client.GraphQL.GetHealthDashboard(context.Background(), start, end)
+	if err != nil {
+		t.Fatalf("GetHealthDashboard failed: %v", err)
+	}
+
+	if sent.Query != HealthDashboardQuery(start, end).String() {
+		t.Errorf("query = %s", sent.Query)
+	}
+	if dashboard.HRV == nil || len(dashboard.HRV.HRVSummaries) != 1 || dashboard.HRV.HRVSummaries[0].LastNightAvg != 48 {
+		t.Errorf("HRV = %+v, want one summary of 48", dashboard.HRV)
+	}
+	if dashboard.Weight != nil {
+		t.Errorf("Weight = %+v, want nil", dashboard.Weight)
+	}
+	if len(dashboard.SleepSummaries) == 0 {
+		t.Error("expected SleepSummaries to be set")
+	}
+	if dashboard.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+}
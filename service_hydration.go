@@ -0,0 +1,140 @@
+// service_hydration.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// hydrationStatsMaxDays is the longest range the hydration stats endpoint accepts per request.
+const hydrationStatsMaxDays = 28
+
+// Volume conversion factors to milliliters.
+const (
+	mlPerLiter      = 1000.0
+	mlPerFluidOunce = 29.5735
+	mlPerCup        = 236.588
+)
+
+// DailyHydration represents hydration data for a single day.
+type DailyHydration struct {
+	UserID                  int64    `json:"userId"`
+	CalendarDate            string   `json:"calendarDate"`
+	ValueInML               *float64 `json:"valueInML"`
+	GoalInML                *float64 `json:"goalInML"`
+	DailyAverageInML        *float64 `json:"dailyAverageinML"`
+	LastEntryTimestampLocal *string  `json:"lastEntryTimestampLocal"`
+	SweatLossInML           *float64 `json:"sweatLossInML"`
+	ActivityIntakeInML      *float64 `json:"activityIntakeInML"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (d *DailyHydration) RawJSON() json.RawMessage { return d.raw }
+
+// SetRaw sets the raw JSON response.
+func (d *DailyHydration) SetRaw(data json.RawMessage) { d.raw = data }
+
+// HydrationLogEntry is the request body for logging hydration intake.
+type HydrationLogEntry struct {
+	CalendarDate   string  `json:"calendarDate"`
+	TimestampLocal string  `json:"timestampLocal"`
+	ValueInML      float64 `json:"valueInML"`
+}
+
+// HydrationStatsValues holds the hydration values for a day.
+type HydrationStatsValues struct {
+	ValueInML *float64 `json:"valueInML"`
+	GoalInML  *float64 `json:"goalInML"`
+}
+
+// HydrationStatsEntry represents hydration stats for a single day.
+type HydrationStatsEntry struct {
+	CalendarDate string               `json:"calendarDate"`
+	Values       HydrationStatsValues `json:"values"`
+}
+
+// HydrationStats represents daily hydration stats over a date range.
+type HydrationStats struct {
+	Items []HydrationStatsEntry
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (h *HydrationStats) RawJSON() json.RawMessage { return h.raw }
+
+// SetRaw sets the raw JSON response.
+func (h *HydrationStats) SetRaw(data json.RawMessage) { h.raw = data }
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (h *HydrationStats) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &h.Items)
+}
+
+// TotalInML returns the total intake over the range.
+func (h *HydrationStats) TotalInML() float64 {
+	total := 0.0
+	for i := range h.Items {
+		if v := h.Items[i].Values.ValueInML; v != nil {
+			total += *v
+		}
+	}
+	return total
+}
+
+// Milliliters returns the container volume converted to milliliters.
+func (h HydrationContainer) Milliliters() (float64, error) {
+	volume := float64(h.Volume)
+	switch strings.ToLower(h.Unit) {
+	case "ml", "milliliter":
+		return volume, nil
+	case "l", "liter":
+		return volume * mlPerLiter, nil
+	case "oz", "ounce", "fl_oz":
+		return volume * mlPerFluidOunce, nil
+	case "cup":
+		return volume * mlPerCup, nil
+	default:
+		return 0, fmt.Errorf("unsupported hydration container unit: %s", h.Unit)
+	}
+}
+
+// FindHydrationContainer returns the container with the given name (case-insensitive).
+func FindHydrationContainer(containers []HydrationContainer, name string) (*HydrationContainer, error) {
+	for i := range containers {
+		if containers[i].Name != nil && strings.EqualFold(*containers[i].Name, name) {
+			return &containers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("hydration container not found: %s", name)
+}
+
+// GetDaily retrieves hydration data for the specified date.
+func (s *HydrationService) GetDaily(ctx context.Context, date time.Time) (*DailyHydration, error) {
+	return fetch[DailyHydration](ctx, s.client, "/usersummary-service/usersummary/hydration/daily/"+date.Format("2006-01-02"))
+}
+
+// Log adds an intake in milliliters to the given date, timestamped with the time of date.
+// A negative value removes intake. Returns the updated daily hydration.
+func (s *HydrationService) Log(ctx context.Context, date time.Time, milliliters float64) (*DailyHydration, error) {
+	entry := HydrationLogEntry{
+		CalendarDate:   date.Format("2006-01-02"),
+		TimestampLocal: date.Format("2006-01-02T15:04:05.000"),
+		ValueInML:      milliliters,
+	}
+	return send[DailyHydration](ctx, s.client, http.MethodPost, "/usersummary-service/usersummary/hydration/log", entry)
+}
+
+// GetStats retrieves daily hydration stats for a date range. Ranges longer than
+// the server maximum are split into several requests and merged.
+func (s *HydrationService) GetStats(ctx context.Context, start, end time.Time) (*HydrationStats, error) {
+	return fetchDateRange[HydrationStats](ctx, s.client, start, end, hydrationStatsMaxDays, func(start, end time.Time) string {
+		return fmt.Sprintf("/usersummary-service/stats/hydration/daily/%s/%s",
+			start.Format("2006-01-02"), end.Format("2006-01-02"))
+	})
+}
@@ -0,0 +1,140 @@
+// service_hydration_test.go
+package garmin
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDailyHydrationJSONUnmarshal(t *testing.T) {
+	rawJSON := `{
+		"userId": 12345678,
+		"calendarDate": "2026-01-27",
+		"valueInML": 1750.0,
+		"goalInML": 2500.0,
+		"dailyAverageinML": 1980.0,
+		"lastEntryTimestampLocal": "2026-01-27T14:05:00.0",
+		"sweatLossInML": 420.0,
+		"activityIntakeInML": 0.0
+	}`
+
+	var hydration DailyHydration
+	if err := json.Unmarshal([]byte(rawJSON), &hydration); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	hydration.SetRaw(json.RawMessage(rawJSON))
+
+	if hydration.CalendarDate != "2026-01-27" {
+		t.Errorf("CalendarDate = %s, want 2026-01-27", hydration.CalendarDate)
+	}
+	if hydration.ValueInML == nil || *hydration.ValueInML != 1750 {
+		t.Errorf("ValueInML = %v, want 1750", hydration.ValueInML)
+	}
+	if hydration.DailyAverageInML == nil || *hydration.DailyAverageInML != 1980 {
+		t.Errorf("DailyAverageInML = %v, want 1980", hydration.DailyAverageInML)
+	}
+	if string(hydration.RawJSON()) != rawJSON {
+		t.Error("RawJSON should return original JSON")
+	}
+}
+
+func TestHydrationStatsJSONUnmarshal(t *testing.T) {
+	rawJSON := `[
+		{"calendarDate": "2026-01-26", "values": {"goalInML": 2500.0, "valueInML": 2000.0}},
+		{"calendarDate": "2026-01-27", "values": {"goalInML": 2500.0, "valueInML": 1750.0}},
+		{"calendarDate": "2026-01-28", "values": {"goalInML": 2500.0, "valueInML": null}}
+	]`
+
+	var stats HydrationStats
+	if err := json.Unmarshal([]byte(rawJSON), &stats); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if len(stats.Items) != 3 {
+		t.Fatalf("len(Items) = %d, want 3", len(stats.Items))
+	}
+	if got := stats.TotalInML(); got != 3750 {
+		t.Errorf("TotalInML() = %f, want 3750", got)
+	}
+}
+
+func TestHydrationContainerMilliliters(t *testing.T) {
+	tests := []struct {
+		container HydrationContainer
+		want      float64
+	}{
+		{HydrationContainer{Volume: 500, Unit: "mL"}, 500},
+		{HydrationContainer{Volume: 1, Unit: "l"}, 1000},
+		{HydrationContainer{Volume: 16, Unit: "oz"}, 473.176},
+		{HydrationContainer{Volume: 1, Unit: "cup"}, 236.588},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.container.Milliliters()
+		if err != nil {
+			t.Errorf("Milliliters(%d %s) error: %v", tt.container.Volume, tt.container.Unit, err)
+			continue
+		}
+		if math.Abs(got-tt.want) > 0.001 {
+			t.Errorf("Milliliters(%d %s) = %f, want %f", tt.container.Volume, tt.container.Unit, got, tt.want)
+		}
+	}
+
+	if _, err := (HydrationContainer{Volume: 1, Unit: "gallon"}).Milliliters(); err == nil {
+		t.Error("expected error for unsupported unit")
+	}
+}
+
+func TestFindHydrationContainer(t *testing.T) {
+	bottle := "Bottle"
+	containers := []HydrationContainer{
+		{Name: nil, Volume: 250, Unit: "mL"},
+		{Name: &bottle, Volume: 750, Unit: "mL"},
+	}
+
+	container, err := FindHydrationContainer(containers, "bottle")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if container.Volume != 750 {
+		t.Errorf("Volume = %d, want 750", container.Volume)
+	}
+
+	if _, err := FindHydrationContainer(containers, "mug"); err == nil {
+		t.Error("expected error for unknown container")
+	}
+}
+
+func TestSplitDateRange(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		end     time.Time
+		maxDays int
+		want    []string
+	}{
+		{"single day", start, 28, []string{"2026-01-01/2026-01-01"}},
+		{"exact chunk", start.AddDate(0, 0, 27), 28, []string{"2026-01-01/2026-01-28"}},
+		{"two chunks", start.AddDate(0, 0, 28), 28, []string{"2026-01-01/2026-01-28", "2026-01-29/2026-01-29"}},
+		{"three chunks", start.AddDate(0, 0, 69), 28, []string{"2026-01-01/2026-01-28", "2026-01-29/2026-02-25", "2026-02-26/2026-03-11"}},
+		{"no maximum", start.AddDate(0, 0, 99), 0, []string{"2026-01-01/2026-04-10"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := splitDateRange(start, tt.end, tt.maxDays)
+			if len(chunks) != len(tt.want) {
+				t.Fatalf("got %d chunks, want %d", len(chunks), len(tt.want))
+			}
+			for i, chunk := range chunks {
+				got := chunk.Start.Format("2006-01-02") + "/" + chunk.End.Format("2006-01-02")
+				if got != tt.want[i] {
+					t.Errorf("chunk %d = %s, want %s", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
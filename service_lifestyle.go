@@ -0,0 +1,247 @@
+// service_lifestyle.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// LifestyleLogStatus is whether a behavior happened on a day.
+type LifestyleLogStatus string
+
+// Lifestyle log statuses. A behavior that was not logged has no status.
+const (
+	LifestyleYes LifestyleLogStatus = "YES"
+	LifestyleNo  LifestyleLogStatus = "NO"
+)
+
+// Names of the built-in lifestyle behaviors. Custom behaviors use the name given by the user.
+const (
+	BehaviorAlcohol  = "Alcohol"
+	BehaviorCaffeine = "Caffeine"
+	BehaviorLateMeal = "Late Meal"
+)
+
+// LifestyleSubType is a detail of a logged behavior, e.g. the kind and number of drinks.
+type LifestyleSubType struct {
+	Name  string   `json:"name"`
+	Value *float64 `json:"value"`
+}
+
+// LifestyleBehavior is a behavior of the daily lifestyle log.
+type LifestyleBehavior struct {
+	BehaviorID int64              `json:"behaviorId"`
+	Name       string             `json:"name"`
+	Category   *string            `json:"category"`
+	Custom     bool               `json:"custom"`
+	LogStatus  LifestyleLogStatus `json:"logStatus"` // empty if not logged
+	SubTypes   []LifestyleSubType `json:"subTypes"`
+}
+
+// Logged returns true if the behavior was logged as having happened.
+func (b *LifestyleBehavior) Logged() bool {
+	return b.LogStatus == LifestyleYes
+}
+
+// Amount returns the sum of the sub type values (e.g. the number of drinks).
+func (b *LifestyleBehavior) Amount() float64 {
+	var total float64
+	for _, st := range b.SubTypes {
+		if st.Value != nil {
+			total += *st.Value
+		}
+	}
+	return total
+}
+
+// LifestyleDailyLog represents the lifestyle behaviors of a day.
+type LifestyleDailyLog struct {
+	CalendarDate    string              `json:"calendarDate"`
+	DailyLogsReport []LifestyleBehavior `json:"dailyLogsReport"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (l *LifestyleDailyLog) RawJSON() json.RawMessage { return l.raw }
+
+// SetRaw sets the raw JSON response.
+func (l *LifestyleDailyLog) SetRaw(data json.RawMessage) { l.raw = data }
+
+// Find returns the behavior with the given name (case-insensitive), or nil if the
+// user does not track it.
+func (l *LifestyleDailyLog) Find(name string) *LifestyleBehavior {
+	for i := range l.DailyLogsReport {
+		if strings.EqualFold(l.DailyLogsReport[i].Name, name) {
+			return &l.DailyLogsReport[i]
+		}
+	}
+	return nil
+}
+
+// LifestyleLogEntry is the status of a behavior to log.
+type LifestyleLogEntry struct {
+	BehaviorID int64              `json:"behaviorId"`
+	LogStatus  LifestyleLogStatus `json:"logStatus"`
+	SubTypes   []LifestyleSubType `json:"subTypes,omitempty"`
+}
+
+// lifestyleLogRequest is the request body to update the daily lifestyle log.
+type lifestyleLogRequest struct {
+	CalendarDate string              `json:"calendarDate"`
+	DailyLogs    []LifestyleLogEntry `json:"dailyLogs"`
+}
+
+// LifestyleDay pairs the behaviors logged on a day with the sleep and HRV of the
+// following night, which is when they show up.
+type LifestyleDay struct {
+	Date      string             `json:"date"` // YYYY-MM-DD
+	Behaviors *LifestyleDailyLog `json:"behaviors"`
+	Sleep     *DailySleep        `json:"sleep"` // night after Date, nil if not recorded
+	HRV       *HRVSummary        `json:"hrv"`   // night after Date, nil if not recorded
+}
+
+// LifestyleRange represents the lifestyle log of consecutive days with the recovery
+// data of the following nights.
+type LifestyleRange struct {
+	Days []LifestyleDay `json:"days"`
+}
+
+// LifestyleImpact compares the nights following days with and without a behavior.
+// Averages are nil when no night of the group has the data.
+type LifestyleImpact struct {
+	Behavior           string   `json:"behavior"`
+	DaysWith           int      `json:"daysWith"`
+	DaysWithout        int      `json:"daysWithout"`
+	AvgSleepSecWith    *float64 `json:"avgSleepSecondsWith"`
+	AvgSleepSecWithout *float64 `json:"avgSleepSecondsWithout"`
+	AvgHRVWith         *float64 `json:"avgHrvWith"`
+	AvgHRVWithout      *float64 `json:"avgHrvWithout"`
+}
+
+// Impact compares sleep duration and overnight HRV after days on which the behavior
+// was logged as happened against days on which it was logged as not happened.
+// Days on which the behavior was not logged are ignored.
+func (r *LifestyleRange) Impact(behavior string) *LifestyleImpact {
+	impact := &LifestyleImpact{Behavior: behavior}
+	var sleepWith, sleepWithout, hrvWith, hrvWithout runningMean
+	for _, day := range r.Days {
+		if day.Behaviors == nil {
+			continue
+		}
+		b := day.Behaviors.Find(behavior)
+		if b == nil || b.LogStatus == "" {
+			continue
+		}
+		sleep, hrv := &sleepWithout, &hrvWithout
+		if b.Logged() {
+			impact.DaysWith++
+			sleep, hrv = &sleepWith, &hrvWith
+		} else {
+			impact.DaysWithout++
+		}
+		if day.Sleep != nil && day.Sleep.HasData() {
+			sleep.add(float64(day.Sleep.DailySleepDTO.SleepSeconds))
+		}
+		if day.HRV != nil && day.HRV.LastNightAvg > 0 {
+			hrv.add(float64(day.HRV.LastNightAvg))
+		}
+	}
+	impact.AvgSleepSecWith = sleepWith.value()
+	impact.AvgSleepSecWithout = sleepWithout.value()
+	impact.AvgHRVWith = hrvWith.value()
+	impact.AvgHRVWithout = hrvWithout.value()
+	return impact
+}
+
+// runningMean accumulates values for a mean.
+type runningMean struct {
+	sum   float64
+	count int
+}
+
+// add adds a value to the mean.
+func (a *runningMean) add(v float64) {
+	a.sum += v
+	a.count++
+}
+
+// value returns the mean, or nil if no value was added.
+func (a *runningMean) value() *float64 {
+	if a.count == 0 {
+		return nil
+	}
+	v := a.sum / float64(a.count)
+	return &v
+}
+
+// GetDaily retrieves the lifestyle behaviors of a day.
+func (s *LifestyleService) GetDaily(ctx context.Context, date time.Time) (*LifestyleDailyLog, error) {
+	return fetch[LifestyleDailyLog](ctx, s.client, "/lifestylelogging-service/dailyLog/"+date.Format("2006-01-02"))
+}
+
+// Update logs the status of behaviors for a day. Behaviors not in entries are left unchanged.
+func (s *LifestyleService) Update(ctx context.Context, date time.Time, entries ...LifestyleLogEntry) error {
+	if len(entries) == 0 {
+		return errors.New("no behavior to log")
+	}
+	req := &lifestyleLogRequest{CalendarDate: date.Format("2006-01-02"), DailyLogs: entries}
+	_, err := send[ignoredResponse](ctx, s.client, http.MethodPut, "/lifestylelogging-service/dailyLog", req)
+	return err
+}
+
+// LogBehavior logs whether the named behavior happened on a day, resolving the
+// behavior ID from the user's daily log. Returns ErrNotFound if the user does not
+// track the behavior.
+func (s *LifestyleService) LogBehavior(ctx context.Context, date time.Time, name string, status LifestyleLogStatus, subTypes ...LifestyleSubType) error {
+	log, err := s.GetDaily(ctx, date)
+	if err != nil {
+		return err
+	}
+	behavior := log.Find(name)
+	if behavior == nil {
+		return fmt.Errorf("lifestyle behavior %q: %w", name, ErrNotFound)
+	}
+	return s.Update(ctx, date, LifestyleLogEntry{BehaviorID: behavior.BehaviorID, LogStatus: status, SubTypes: subTypes})
+}
+
+// GetRange retrieves the lifestyle log of each day between start and end, paired
+// with the sleep (SleepService.GetDaily) and HRV (HRVService.GetRange) of the
+// following night. This makes one request per day for the log and for sleep.
+func (s *LifestyleService) GetRange(ctx context.Context, start, end time.Time) (*LifestyleRange, error) {
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	hrvByDate := make(map[string]*HRVSummary)
+	hrv, err := s.client.HRV.GetRange(ctx, start.AddDate(0, 0, 1), end.AddDate(0, 0, 1))
+	switch {
+	case err == nil:
+		for i := range hrv.HRVSummaries {
+			hrvByDate[hrv.HRVSummaries[i].CalendarDate] = &hrv.HRVSummaries[i]
+		}
+	case !IsNotFound(err):
+		return nil, fmt.Errorf("get hrv: %w", err)
+	}
+
+	result := &LifestyleRange{}
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		day := LifestyleDay{Date: date.Format("2006-01-02")}
+		night := date.AddDate(0, 0, 1)
+
+		if day.Behaviors, err = s.GetDaily(ctx, date); err != nil && !IsNotFound(err) {
+			return nil, fmt.Errorf("get lifestyle log for %s: %w", day.Date, err)
+		}
+		if day.Sleep, err = s.client.Sleep.GetDaily(ctx, night); err != nil && !IsNotFound(err) {
+			return nil, fmt.Errorf("get sleep for %s: %w", night.Format("2006-01-02"), err)
+		}
+		day.HRV = hrvByDate[night.Format("2006-01-02")]
+		result.Days = append(result.Days, day)
+	}
+	return result, nil
+}
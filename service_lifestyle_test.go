@@ -0,0 +1,146 @@
+// service_lifestyle_test.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// testLifestyleLogJSON returns a daily log where alcohol has the given status.
+func testLifestyleLogJSON(date, alcohol string) []byte {
+	return []byte(`{"calendarDate": "` + date + `", "dailyLogsReport": [
+		{"behaviorId": 1, "name": "Alcohol", "logStatus": ` + alcohol + `, "subTypes": [{"name": "BEER", "value": 2}, {"name": "WINE", "value": 1}]},
+		{"behaviorId": 2, "name": "Caffeine", "logStatus": null},
+		{"behaviorId": 900, "name": "Meditation", "custom": true, "logStatus": "NO"}
+	]}`)
+}
+
+func TestLifestyleDailyLogFind(t *testing.T) {
+	var log LifestyleDailyLog
+	if err := json.Unmarshal(testLifestyleLogJSON("2026-01-27", `"YES"`), &log); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	alcohol := log.Find("alcohol")
+	if alcohol == nil || !alcohol.Logged() || alcohol.Amount() != 3 {
+		t.Errorf("Find(alcohol) = %+v, want logged with amount 3", alcohol)
+	}
+	if caffeine := log.Find(BehaviorCaffeine); caffeine == nil || caffeine.Logged() || caffeine.LogStatus != "" {
+		t.Errorf("Find(Caffeine) = %+v, want not logged", caffeine)
+	}
+	if meditation := log.Find("Meditation"); meditation == nil || !meditation.Custom || meditation.Logged() {
+		t.Errorf("Find(Meditation) = %+v, want custom behavior logged as NO", meditation)
+	}
+	if log.Find("Nap") != nil {
+		t.Error("Find(Nap) should return nil for an untracked behavior")
+	}
+}
+
+func TestLifestyleLogBehavior(t *testing.T) {
+	var body lifestyleLogRequest
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/lifestylelogging-service/dailyLog/2026-01-27":
+			return http.StatusOK, testLifestyleLogJSON("2026-01-27", "null")
+		case req.Method == http.MethodPut && req.URL.Path == "/lifestylelogging-service/dailyLog":
+			data, _ := io.ReadAll(req.Body)
+			if err := json.Unmarshal(data, &body); err != nil {
+				t.Errorf("invalid request body: %v", err)
+			}
+			return http.StatusNoContent, nil
+		default:
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+			return http.StatusNotFound, nil
+		}
+	})
+	ctx := context.Background()
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	if err := client.Lifestyle.LogBehavior(ctx, date, "caffeine", LifestyleYes); err != nil {
+		t.Fatalf("LogBehavior failed: %v", err)
+	}
+	if body.CalendarDate != "2026-01-27" || len(body.DailyLogs) != 1 ||
+		body.DailyLogs[0].BehaviorID != 2 || body.DailyLogs[0].LogStatus != LifestyleYes {
+		t.Errorf("request body = %+v, want caffeine (2) logged as YES on 2026-01-27", body)
+	}
+
+	if err := client.Lifestyle.LogBehavior(ctx, date, "Nap", LifestyleYes); !errors.Is(err, ErrNotFound) {
+		t.Errorf("LogBehavior(untracked) error = %v, want ErrNotFound", err)
+	}
+	if err := client.Lifestyle.Update(ctx, date); err == nil {
+		t.Error("expected error when updating without entries")
+	}
+}
+
+func TestLifestyleGetRange(t *testing.T) {
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		switch req.URL.Path {
+		case "/hrv-service/hrv/daily/2026-01-26/2026-01-28":
+			return http.StatusOK, []byte(`{"hrvSummaries": [
+				{"calendarDate": "2026-01-26", "lastNightAvg": 40},
+				{"calendarDate": "2026-01-27", "lastNightAvg": 60},
+				{"calendarDate": "2026-01-28", "lastNightAvg": 62}
+			]}`)
+		case "/lifestylelogging-service/dailyLog/2026-01-25":
+			return http.StatusOK, testLifestyleLogJSON("2026-01-25", `"YES"`)
+		case "/lifestylelogging-service/dailyLog/2026-01-26":
+			return http.StatusOK, testLifestyleLogJSON("2026-01-26", `"NO"`)
+		case "/lifestylelogging-service/dailyLog/2026-01-27":
+			return http.StatusNoContent, nil
+		case "/sleep-service/sleep/dailySleepData":
+			switch req.URL.Query().Get("date") {
+			case "2026-01-26":
+				return http.StatusOK, []byte(`{"dailySleepDTO": {"id": 1, "calendarDate": "2026-01-26", "sleepTimeSeconds": 21600}}`)
+			case "2026-01-27":
+				return http.StatusOK, []byte(`{"dailySleepDTO": {"id": 2, "calendarDate": "2026-01-27", "sleepTimeSeconds": 28800}}`)
+			}
+			return http.StatusOK, []byte(`{"dailySleepDTO": {"calendarDate": "2026-01-28"}}`)
+		default:
+			t.Errorf("unexpected request: %s", req.URL)
+			return http.StatusNotFound, nil
+		}
+	})
+
+	lifestyle, err := client.Lifestyle.GetRange(context.Background(),
+		time.Date(2026, 1, 25, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetRange failed: %v", err)
+	}
+	if len(lifestyle.Days) != 3 {
+		t.Fatalf("len(Days) = %d, want 3", len(lifestyle.Days))
+	}
+
+	first := lifestyle.Days[0]
+	if first.Date != "2026-01-25" || first.Behaviors == nil || first.Sleep == nil || first.HRV == nil {
+		t.Fatalf("Days[0] = %+v, want behaviors, sleep and HRV", first)
+	}
+	// Behaviors of a day are paired with the following night
+	if first.Sleep.DailySleepDTO.CalendarDate != "2026-01-26" || first.HRV.CalendarDate != "2026-01-26" {
+		t.Errorf("Days[0] paired with sleep %s and HRV %s, want 2026-01-26",
+			first.Sleep.DailySleepDTO.CalendarDate, first.HRV.CalendarDate)
+	}
+	if lifestyle.Days[2].Behaviors != nil {
+		t.Errorf("Days[2].Behaviors = %+v, want nil for a day without log", lifestyle.Days[2].Behaviors)
+	}
+
+	impact := lifestyle.Impact(BehaviorAlcohol)
+	if impact.DaysWith != 1 || impact.DaysWithout != 1 {
+		t.Errorf("DaysWith/DaysWithout = %d/%d, want 1/1", impact.DaysWith, impact.DaysWithout)
+	}
+	if impact.AvgSleepSecWith == nil || *impact.AvgSleepSecWith != 21600 ||
+		impact.AvgSleepSecWithout == nil || *impact.AvgSleepSecWithout != 28800 {
+		t.Errorf("AvgSleepSec with/without = %v/%v, want 21600/28800", impact.AvgSleepSecWith, impact.AvgSleepSecWithout)
+	}
+	if impact.AvgHRVWith == nil || *impact.AvgHRVWith != 40 || impact.AvgHRVWithout == nil || *impact.AvgHRVWithout != 60 {
+		t.Errorf("AvgHRV with/without = %v/%v, want 40/60", impact.AvgHRVWith, impact.AvgHRVWithout)
+	}
+
+	if caffeine := lifestyle.Impact(BehaviorCaffeine); caffeine.DaysWith != 0 || caffeine.AvgHRVWith != nil {
+		t.Errorf("Impact(Caffeine) = %+v, want no logged days", caffeine)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"time"
 )
 
@@ -165,6 +166,28 @@ func (h *HillScore) SetRaw(data json.RawMessage) {
 	h.raw = data
 }
 
+// HillScoreStats represents hill score statistics over a date range.
+type HillScoreStats struct {
+	UserProfilePK  int64              `json:"userProfilePK"`
+	StartDate      string             `json:"startDate"`
+	EndDate        string             `json:"endDate"`
+	MaxScore       *int               `json:"maxScore"`
+	PeriodAvgScore map[string]float64 `json:"periodAvgScore"` // keyed by the first day of each period
+	HillScores     []HillScore        `json:"hillScoreDTOList"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (h *HillScoreStats) RawJSON() json.RawMessage {
+	return h.raw
+}
+
+// SetRaw sets the raw JSON response.
+func (h *HillScoreStats) SetRaw(data json.RawMessage) {
+	h.raw = data
+}
+
 // HeatAltitudeAcclimation represents heat and altitude acclimation data.
 type HeatAltitudeAcclimation struct {
 	CalendarDate                      string  `json:"calendarDate"`
@@ -408,6 +431,13 @@ func (s *MetricsService) GetHillScore(ctx context.Context, date time.Time) (*Hil
 	return fetch[HillScore](ctx, s.client, path)
 }
 
+// GetHillScoreStats retrieves hill score statistics for a date range.
+func (s *MetricsService) GetHillScoreStats(ctx context.Context, startDate, endDate time.Time, aggregation Aggregation) (*HillScoreStats, error) {
+	path := fmt.Sprintf("/metrics-service/metrics/hillscore/stats?startDate=%s&endDate=%s&aggregation=%s",
+		startDate.Format("2006-01-02"), endDate.Format("2006-01-02"), aggregation)
+	return fetch[HillScoreStats](ctx, s.client, path)
+}
+
 // GetMaxMetLatest retrieves the latest VO2 max / MET data.
 func (s *MetricsService) GetMaxMetLatest(ctx context.Context, date time.Time) (*MaxMetLatest, error) {
 	path := "/metrics-service/metrics/maxmet/latest/" + date.Format("2006-01-02")
@@ -495,3 +525,43 @@ func (s *MetricsService) GetRacePredictionsLatest(ctx context.Context, displayNa
 	path := "/metrics-service/metrics/racepredictions/latest/" + displayName
 	return fetch[RacePredictions](ctx, s.client, path)
 }
+
+// RacePredictionsSeries represents the race predictions over a date range, one
+// entry per day or per month.
+type RacePredictionsSeries struct {
+	Items []RacePredictions
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (r *RacePredictionsSeries) RawJSON() json.RawMessage {
+	return r.raw
+}
+
+// SetRaw sets the raw JSON response.
+func (r *RacePredictionsSeries) SetRaw(data json.RawMessage) {
+	r.raw = data
+}
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (r *RacePredictionsSeries) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &r.Items)
+}
+
+// GetRacePredictionsRange retrieves the history of race predictions between two
+// dates. granularity must be AggregationDaily or AggregationMonthly. If displayName
+// is empty, the current user's display name is fetched from the social profile.
+func (s *MetricsService) GetRacePredictionsRange(ctx context.Context, displayName string, startDate, endDate time.Time, granularity Aggregation) (*RacePredictionsSeries, error) {
+	if granularity != AggregationDaily && granularity != AggregationMonthly {
+		return nil, fmt.Errorf("invalid race predictions granularity: %s (valid: daily, monthly)", granularity)
+	}
+	displayName, err := s.client.UserProfile.resolveDisplayName(ctx, displayName)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("fromCalendarDate", startDate.Format("2006-01-02"))
+	params.Set("toCalendarDate", endDate.Format("2006-01-02"))
+	path := fmt.Sprintf("/metrics-service/metrics/racepredictions/%s/%s?%s", granularity, url.PathEscape(displayName), params.Encode())
+	return fetch[RacePredictionsSeries](ctx, s.client, path)
+}
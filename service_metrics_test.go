@@ -2,8 +2,11 @@
 package garmin
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
+	"time"
 )
 
 const testDateMetrics = "2026-01-27"
@@ -363,3 +366,69 @@ func TestTrainingReadinessRawJSON(t *testing.T) {
 		t.Error("RawJSON should return original JSON")
 	}
 }
+
+func TestHillScoreStatsJSONUnmarshal(t *testing.T) {
+	rawJSON := `{
+		"userProfilePK": 12345678,
+		"startDate": "2026-01-01",
+		"endDate": "2026-01-27",
+		"maxScore": 62,
+		"periodAvgScore": {"2026-01-01": 58.5, "2026-01-08": 60.0},
+		"hillScoreDTOList": [
+			{"calendarDate": "2026-01-05", "strengthScore": 45, "enduranceScore": 70, "overallScore": 58},
+			{"calendarDate": "2026-01-12", "strengthScore": 48, "enduranceScore": 74, "overallScore": 62}
+		]
+	}`
+
+	var stats HillScoreStats
+	if err := json.Unmarshal([]byte(rawJSON), &stats); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if stats.MaxScore == nil || *stats.MaxScore != 62 {
+		t.Errorf("MaxScore = %v, want 62", stats.MaxScore)
+	}
+	if stats.PeriodAvgScore["2026-01-08"] != 60 {
+		t.Errorf("PeriodAvgScore[2026-01-08] = %v, want 60", stats.PeriodAvgScore["2026-01-08"])
+	}
+	if len(stats.HillScores) != 2 || stats.HillScores[1].OverallScore != 62 {
+		t.Errorf("HillScores = %+v, want 2 scores ending at 62", stats.HillScores)
+	}
+}
+
+func TestGetRacePredictionsRange(t *testing.T) {
+	var requested string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.URL.Path == "/userprofile-service/socialProfile" {
+			return http.StatusOK, []byte(`{"displayName": "runner"}`)
+		}
+		requested = req.URL.Path + "?" + req.URL.RawQuery
+		return http.StatusOK, []byte(`[
+			{"calendarDate": "2026-01-01", "time5K": 1260, "time10K": 2640, "timeHalfMarathon": 5880, "timeMarathon": 12600},
+			{"calendarDate": "2026-01-27", "time5K": 1230, "time10K": 2580, "timeHalfMarathon": 5760, "timeMarathon": 12300}
+		]`)
+	})
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	series, err := client.Metrics.GetRacePredictionsRange(ctx, "", start, end, AggregationDaily)
+	if err != nil {
+		t.Fatalf("GetRacePredictionsRange failed: %v", err)
+	}
+
+	want := "/metrics-service/metrics/racepredictions/daily/runner?fromCalendarDate=2026-01-01&toCalendarDate=2026-01-27"
+	if requested != want {
+		t.Errorf("requested %s, want %s", requested, want)
+	}
+	if len(series.Items) != 2 {
+		t.Fatalf("len(Items) = %d, want 2", len(series.Items))
+	}
+	if got := series.Items[1].TimeMarathonDuration(); got != 12300*time.Second {
+		t.Errorf("TimeMarathonDuration() = %v, want 3h25m", got)
+	}
+
+	if _, err := client.Metrics.GetRacePredictionsRange(ctx, "runner", start, end, AggregationWeekly); err == nil {
+		t.Error("expected an error for weekly granularity")
+	}
+}
@@ -0,0 +1,208 @@
+// service_periodichealth.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CyclePhase is a phase of the menstrual cycle (Garmin's phase number).
+type CyclePhase int
+
+// Menstrual cycle phases.
+const (
+	CyclePhaseUnknown    CyclePhase = 0
+	CyclePhaseMenstrual  CyclePhase = 1
+	CyclePhaseFollicular CyclePhase = 2
+	CyclePhaseOvulation  CyclePhase = 3
+	CyclePhaseLuteal     CyclePhase = 4
+)
+
+// cyclePhaseNames maps known cycle phases to their name.
+var cyclePhaseNames = map[CyclePhase]string{
+	CyclePhaseMenstrual:  "menstrual",
+	CyclePhaseFollicular: "follicular",
+	CyclePhaseOvulation:  "ovulation",
+	CyclePhaseLuteal:     "luteal",
+}
+
+// String returns the name of the cycle phase.
+func (p CyclePhase) String() string {
+	if name, ok := cyclePhaseNames[p]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// cyclePhaseForDay computes the phase of a day of the cycle (1-based) from the
+// period length and the fertile window. It returns CyclePhaseUnknown if the
+// cycle lacks the information.
+func cyclePhaseForDay(day int, periodLength, fertileWindowStart, fertileWindowLength *int) CyclePhase {
+	if day < 1 || periodLength == nil {
+		return CyclePhaseUnknown
+	}
+	if day <= *periodLength {
+		return CyclePhaseMenstrual
+	}
+	if fertileWindowStart == nil || fertileWindowLength == nil {
+		return CyclePhaseUnknown
+	}
+	switch {
+	case day < *fertileWindowStart:
+		return CyclePhaseFollicular
+	case day < *fertileWindowStart+*fertileWindowLength:
+		return CyclePhaseOvulation
+	default:
+		return CyclePhaseLuteal
+	}
+}
+
+// MenstrualCycleDaySummary describes the cycle a day belongs to.
+type MenstrualCycleDaySummary struct {
+	StartDate             string     `json:"startDate"` // first day of the cycle, YYYY-MM-DD
+	DayInCycle            int        `json:"dayInCycle"`
+	CurrentPhase          CyclePhase `json:"currentPhase"`
+	DaysUntilNextPhase    *int       `json:"daysUntilNextPhase"`
+	CycleType             *string    `json:"cycleType"` // e.g. "NATURAL", "HORMONAL_CONTRACEPTION"
+	PeriodLength          *int       `json:"periodLength"`
+	PredictedCycleLength  *int       `json:"predictedCycleLength"`
+	FertileWindowStart    *int       `json:"fertileWindowStart"` // day of the cycle
+	LengthOfFertileWindow *int       `json:"lengthOfFertileWindow"`
+	LastDayOfPeriod       *int       `json:"lastDayOfPeriod"`
+	PregnancyCycle        bool       `json:"pregnancyCycle"`
+}
+
+// MenstrualCycleDailyLog represents what the user logged for a day.
+type MenstrualCycleDailyLog struct {
+	CalendarDate   string   `json:"calendarDate"`
+	FlowIntensity  *string  `json:"flowIntensity"` // e.g. "LIGHT", "MEDIUM", "HEAVY"
+	Symptoms       []string `json:"symptoms"`
+	Moods          []string `json:"moods"`
+	SexualActivity *string  `json:"sexualActivity"`
+	Notes          *string  `json:"notes"`
+}
+
+// MenstrualCycleDayView represents the menstrual cycle information of a day.
+type MenstrualCycleDayView struct {
+	DaySummary *MenstrualCycleDaySummary `json:"daySummary"`
+	DailyLog   *MenstrualCycleDailyLog   `json:"dailyLog"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (m *MenstrualCycleDayView) RawJSON() json.RawMessage { return m.raw }
+
+// SetRaw sets the raw JSON response.
+func (m *MenstrualCycleDayView) SetRaw(data json.RawMessage) { m.raw = data }
+
+// Phase returns the cycle phase of the day, or CyclePhaseUnknown if the day is
+// not part of a tracked cycle.
+func (m *MenstrualCycleDayView) Phase() CyclePhase {
+	if m.DaySummary == nil {
+		return CyclePhaseUnknown
+	}
+	return m.DaySummary.CurrentPhase
+}
+
+// MenstrualCycle represents a cycle of the menstrual calendar.
+type MenstrualCycle struct {
+	StartDate             string  `json:"startDate"` // YYYY-MM-DD
+	EndDate               *string `json:"endDate"`   // nil for the ongoing cycle
+	CycleType             *string `json:"cycleType"`
+	CycleLength           *int    `json:"cycleLength"`
+	PredictedCycleLength  *int    `json:"predictedCycleLength"`
+	PeriodLength          *int    `json:"periodLength"`
+	FertileWindowStart    *int    `json:"fertileWindowStart"` // day of the cycle
+	LengthOfFertileWindow *int    `json:"lengthOfFertileWindow"`
+	Predicted             bool    `json:"predictedCycle"` // true for forecast cycles
+	PregnancyCycle        bool    `json:"pregnancyCycle"`
+}
+
+// Start returns the first day of the cycle.
+func (m *MenstrualCycle) Start() time.Time {
+	d, _ := time.Parse("2006-01-02", m.StartDate)
+	return d
+}
+
+// PhaseOn returns the phase of the cycle on the given date, or CyclePhaseUnknown
+// if the date is outside the cycle or the cycle lacks the information.
+func (m *MenstrualCycle) PhaseOn(date time.Time) CyclePhase {
+	start := m.Start()
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	if m.EndDate != nil {
+		if end, err := time.Parse("2006-01-02", *m.EndDate); err == nil && day.After(end) {
+			return CyclePhaseUnknown
+		}
+	}
+	return cyclePhaseForDay(int(day.Sub(start).Hours()/24)+1, m.PeriodLength, m.FertileWindowStart, m.LengthOfFertileWindow)
+}
+
+// MenstrualCalendar represents the menstrual cycles over a date range.
+type MenstrualCalendar struct {
+	CycleSummaries []MenstrualCycle `json:"cycleSummaries"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (m *MenstrualCalendar) RawJSON() json.RawMessage { return m.raw }
+
+// SetRaw sets the raw JSON response.
+func (m *MenstrualCalendar) SetRaw(data json.RawMessage) { m.raw = data }
+
+// CycleOn returns the cycle containing the given date, or nil if none does.
+func (m *MenstrualCalendar) CycleOn(date time.Time) *MenstrualCycle {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	var found *MenstrualCycle
+	for i := range m.CycleSummaries {
+		// The last cycle started on or before the date wins
+		if start := m.CycleSummaries[i].Start(); !start.After(day) && (found == nil || start.After(found.Start())) {
+			found = &m.CycleSummaries[i]
+		}
+	}
+	return found
+}
+
+// PregnancySnapshot represents the current pregnancy of the user.
+type PregnancySnapshot struct {
+	StartDate         *string `json:"startDate"` // YYYY-MM-DD
+	DueDate           *string `json:"dueDate"`
+	DeliveryDate      *string `json:"deliveryDate"`
+	WeekOfPregnancy   *int    `json:"weekOfPregnancy"`
+	DayOfPregnancy    *int    `json:"dayOfPregnancy"`
+	Trimester         *int    `json:"trimester"`
+	NumberOfBabies    *string `json:"numberOfBabies"` // e.g. "SINGLE", "TWINS"
+	PregnancyStatus   *string `json:"pregnancyStatus"`
+	BloodGlucoseAlert *bool   `json:"bloodGlucoseAlert"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (p *PregnancySnapshot) RawJSON() json.RawMessage { return p.raw }
+
+// SetRaw sets the raw JSON response.
+func (p *PregnancySnapshot) SetRaw(data json.RawMessage) { p.raw = data }
+
+// GetDayView retrieves the menstrual cycle information for a specific date.
+func (s *PeriodicHealthService) GetDayView(ctx context.Context, date time.Time) (*MenstrualCycleDayView, error) {
+	path := "/periodichealth-service/menstrualcycle/dayview/" + date.Format("2006-01-02")
+	return fetch[MenstrualCycleDayView](ctx, s.client, path)
+}
+
+// GetCalendar retrieves the menstrual cycles between two dates, including
+// predicted cycles.
+func (s *PeriodicHealthService) GetCalendar(ctx context.Context, start, end time.Time) (*MenstrualCalendar, error) {
+	path := fmt.Sprintf("/periodichealth-service/menstrualcycle/calendar/%s/%s",
+		start.Format("2006-01-02"), end.Format("2006-01-02"))
+	return fetch[MenstrualCalendar](ctx, s.client, path)
+}
+
+// GetPregnancySnapshot retrieves the current pregnancy of the user.
+// Returns ErrNotFound if no pregnancy is being tracked.
+func (s *PeriodicHealthService) GetPregnancySnapshot(ctx context.Context) (*PregnancySnapshot, error) {
+	return fetch[PregnancySnapshot](ctx, s.client, "/periodichealth-service/menstrualcycle/pregnancysnapshot")
+}
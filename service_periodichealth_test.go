@@ -0,0 +1,139 @@
+// service_periodichealth_test.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCyclePhaseString(t *testing.T) {
+	tests := []struct {
+		phase CyclePhase
+		want  string
+	}{
+		{CyclePhaseMenstrual, "menstrual"},
+		{CyclePhaseFollicular, "follicular"},
+		{CyclePhaseOvulation, "ovulation"},
+		{CyclePhaseLuteal, "luteal"},
+		{CyclePhase(9), "unknown"},
+	}
+	for _, tt := range tests {
+		if got := tt.phase.String(); got != tt.want {
+			t.Errorf("CyclePhase(%d).String() = %q, want %q", int(tt.phase), got, tt.want)
+		}
+	}
+}
+
+func TestMenstrualCycleDayViewJSONUnmarshal(t *testing.T) {
+	data := `{
+		"daySummary": {"startDate": "2026-01-20", "dayInCycle": 8, "currentPhase": 2,
+			"periodLength": 5, "predictedCycleLength": 28, "fertileWindowStart": 11, "lengthOfFertileWindow": 6},
+		"dailyLog": {"calendarDate": "2026-01-27", "symptoms": ["HEADACHE"], "moods": []}
+	}`
+	var dayView MenstrualCycleDayView
+	if err := json.Unmarshal([]byte(data), &dayView); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if dayView.DaySummary.DayInCycle != 8 {
+		t.Errorf("DayInCycle = %d, want 8", dayView.DaySummary.DayInCycle)
+	}
+	if dayView.Phase() != CyclePhaseFollicular {
+		t.Errorf("Phase() = %v, want follicular", dayView.Phase())
+	}
+	if len(dayView.DailyLog.Symptoms) != 1 || dayView.DailyLog.Symptoms[0] != "HEADACHE" {
+		t.Errorf("Symptoms = %v, want [HEADACHE]", dayView.DailyLog.Symptoms)
+	}
+
+	var empty MenstrualCycleDayView
+	if empty.Phase() != CyclePhaseUnknown {
+		t.Errorf("Phase() without summary = %v, want unknown", empty.Phase())
+	}
+}
+
+func TestMenstrualCalendarPhases(t *testing.T) {
+	data := `{"cycleSummaries": [
+		{"startDate": "2025-12-23", "endDate": "2026-01-19", "cycleLength": 28, "periodLength": 5,
+			"fertileWindowStart": 11, "lengthOfFertileWindow": 6},
+		{"startDate": "2026-01-20", "periodLength": 4, "fertileWindowStart": 12, "lengthOfFertileWindow": 6},
+		{"startDate": "2026-02-17", "predictedCycle": true}
+	]}`
+	var calendar MenstrualCalendar
+	if err := json.Unmarshal([]byte(data), &calendar); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	tests := []struct {
+		date      string
+		wantStart string
+		want      CyclePhase
+	}{
+		{"2025-12-23", "2025-12-23", CyclePhaseMenstrual},
+		{"2025-12-28", "2025-12-23", CyclePhaseFollicular},
+		{"2026-01-02", "2025-12-23", CyclePhaseOvulation},
+		{"2026-01-10", "2025-12-23", CyclePhaseLuteal},
+		{"2026-01-23", "2026-01-20", CyclePhaseMenstrual},
+		{"2026-01-24", "2026-01-20", CyclePhaseFollicular},
+		{"2026-02-20", "2026-02-17", CyclePhaseUnknown}, // predicted cycle without details
+	}
+	for _, tt := range tests {
+		date, _ := time.Parse("2006-01-02", tt.date)
+		cycle := calendar.CycleOn(date)
+		if cycle == nil || cycle.StartDate != tt.wantStart {
+			t.Errorf("CycleOn(%s) = %+v, want cycle starting %s", tt.date, cycle, tt.wantStart)
+			continue
+		}
+		if got := cycle.PhaseOn(date); got != tt.want {
+			t.Errorf("PhaseOn(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+
+	if cycle := calendar.CycleOn(time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)); cycle != nil {
+		t.Errorf("CycleOn before the first cycle = %+v, want nil", cycle)
+	}
+	past := &calendar.CycleSummaries[0]
+	if got := past.PhaseOn(time.Date(2026, 1, 25, 0, 0, 0, 0, time.UTC)); got != CyclePhaseUnknown {
+		t.Errorf("PhaseOn after the end of the cycle = %v, want unknown", got)
+	}
+}
+
+func TestPeriodicHealthRequests(t *testing.T) {
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		switch req.URL.Path {
+		case "/periodichealth-service/menstrualcycle/dayview/2026-01-27":
+			return http.StatusOK, []byte(`{"daySummary": {"dayInCycle": 8, "currentPhase": 2}}`)
+		case "/periodichealth-service/menstrualcycle/calendar/2026-01-01/2026-01-31":
+			return http.StatusOK, []byte(`{"cycleSummaries": [{"startDate": "2026-01-20"}]}`)
+		case "/periodichealth-service/menstrualcycle/pregnancysnapshot":
+			return http.StatusNoContent, nil
+		default:
+			t.Errorf("unexpected request: %s", req.URL.Path)
+			return http.StatusNotFound, nil
+		}
+	})
+	ctx := context.Background()
+
+	dayView, err := client.PeriodicHealth.GetDayView(ctx, time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetDayView failed: %v", err)
+	}
+	if dayView.Phase() != CyclePhaseFollicular || dayView.RawJSON() == nil {
+		t.Errorf("GetDayView = %+v, want follicular phase with raw JSON", dayView)
+	}
+
+	calendar, err := client.PeriodicHealth.GetCalendar(ctx,
+		time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetCalendar failed: %v", err)
+	}
+	if len(calendar.CycleSummaries) != 1 {
+		t.Errorf("len(CycleSummaries) = %d, want 1", len(calendar.CycleSummaries))
+	}
+
+	if _, err := client.PeriodicHealth.GetPregnancySnapshot(ctx); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetPregnancySnapshot error = %v, want ErrNotFound", err)
+	}
+}
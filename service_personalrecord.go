@@ -0,0 +1,167 @@
+// service_personalrecord.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// PersonalRecordType identifies the kind of a personal record (Garmin's typeId).
+type PersonalRecordType int
+
+// Known personal record types.
+const (
+	PRTypeRun1K             PersonalRecordType = 1
+	PRTypeRun1Mile          PersonalRecordType = 2
+	PRTypeRun5K             PersonalRecordType = 3
+	PRTypeRun10K            PersonalRecordType = 4
+	PRTypeRunHalfMarathon   PersonalRecordType = 5
+	PRTypeRunMarathon       PersonalRecordType = 6
+	PRTypeLongestRun        PersonalRecordType = 7
+	PRTypeLongestRide       PersonalRecordType = 8
+	PRTypeRideTotalAscent   PersonalRecordType = 9
+	PRTypeRideMaxAvgPower   PersonalRecordType = 10
+	PRTypeRide40K           PersonalRecordType = 11
+	PRTypeMostStepsDay      PersonalRecordType = 12
+	PRTypeMostStepsWeek     PersonalRecordType = 13
+	PRTypeMostStepsMonth    PersonalRecordType = 14
+	PRTypeLongestGoalStreak PersonalRecordType = 15
+)
+
+// PersonalRecordUnit describes how the value of a personal record is measured.
+type PersonalRecordUnit string
+
+// Personal record value units.
+const (
+	PRUnitDuration PersonalRecordUnit = "duration" // seconds
+	PRUnitDistance PersonalRecordUnit = "distance" // meters
+	PRUnitPower    PersonalRecordUnit = "power"    // watts
+	PRUnitSteps    PersonalRecordUnit = "steps"
+	PRUnitDays     PersonalRecordUnit = "days"
+	PRUnitUnknown  PersonalRecordUnit = "unknown"
+)
+
+// personalRecordTypes maps known record types to their name and unit.
+var personalRecordTypes = map[PersonalRecordType]struct {
+	name string
+	unit PersonalRecordUnit
+}{
+	PRTypeRun1K:             {"1K", PRUnitDuration},
+	PRTypeRun1Mile:          {"1 mile", PRUnitDuration},
+	PRTypeRun5K:             {"5K", PRUnitDuration},
+	PRTypeRun10K:            {"10K", PRUnitDuration},
+	PRTypeRunHalfMarathon:   {"half marathon", PRUnitDuration},
+	PRTypeRunMarathon:       {"marathon", PRUnitDuration},
+	PRTypeLongestRun:        {"longest run", PRUnitDistance},
+	PRTypeLongestRide:       {"longest ride", PRUnitDistance},
+	PRTypeRideTotalAscent:   {"ride total ascent", PRUnitDistance},
+	PRTypeRideMaxAvgPower:   {"max avg power (20 min)", PRUnitPower},
+	PRTypeRide40K:           {"40K ride", PRUnitDuration},
+	PRTypeMostStepsDay:      {"most steps in a day", PRUnitSteps},
+	PRTypeMostStepsWeek:     {"most steps in a week", PRUnitSteps},
+	PRTypeMostStepsMonth:    {"most steps in a month", PRUnitSteps},
+	PRTypeLongestGoalStreak: {"longest goal streak", PRUnitDays},
+}
+
+// String returns the human-readable name of the record type.
+func (t PersonalRecordType) String() string {
+	if info, ok := personalRecordTypes[t]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("record type %d", int(t))
+}
+
+// Unit returns how the value of this record type is measured.
+func (t PersonalRecordType) Unit() PersonalRecordUnit {
+	if info, ok := personalRecordTypes[t]; ok {
+		return info.unit
+	}
+	return PRUnitUnknown
+}
+
+// PersonalRecord represents a single personal record.
+type PersonalRecord struct {
+	ID                                  int64              `json:"id"`
+	TypeID                              PersonalRecordType `json:"typeId"`
+	ActivityID                          int64              `json:"activityId"`
+	ActivityName                        *string            `json:"activityName"`
+	ActivityType                        *string            `json:"activityType"`
+	ActivityStartDateTimeInGMT          *int64             `json:"activityStartDateTimeInGMT"`
+	ActStartDateTimeInGMTFormatted      *string            `json:"actStartDateTimeInGMTFormatted"`
+	ActivityStartDateTimeLocal          *int64             `json:"activityStartDateTimeLocal"`
+	ActivityStartDateTimeLocalFormatted *string            `json:"activityStartDateTimeLocalFormatted"`
+	Value                               float64            `json:"value"`
+	PRStartTimeGMT                      int64              `json:"prStartTimeGmt"`
+	PRStartTimeGMTFormatted             string             `json:"prStartTimeGmtFormatted"`
+	PRStartTimeLocal                    int64              `json:"prStartTimeLocal"`
+	PRStartTimeLocalFormatted           string             `json:"prStartTimeLocalFormatted"`
+	PRTypeLabelKey                      *string            `json:"prTypeLabelKey"`
+	PoolLengthUnit                      *string            `json:"poolLengthUnit"`
+}
+
+// Name returns the human-readable name of the record.
+func (p *PersonalRecord) Name() string {
+	return p.TypeID.String()
+}
+
+// Duration returns the record value as a duration, or false if the record is not timed.
+func (p *PersonalRecord) Duration() (time.Duration, bool) {
+	if p.TypeID.Unit() != PRUnitDuration {
+		return 0, false
+	}
+	return time.Duration(p.Value * float64(time.Second)), true
+}
+
+// Meters returns the record value in meters, or false if the record is not a distance.
+func (p *PersonalRecord) Meters() (float64, bool) {
+	if p.TypeID.Unit() != PRUnitDistance {
+		return 0, false
+	}
+	return p.Value, true
+}
+
+// HasActivity returns true if the record is linked to an activity, which can be
+// fetched with ActivityService.Get.
+func (p *PersonalRecord) HasActivity() bool {
+	return p.ActivityID != 0
+}
+
+// PersonalRecords represents all personal records of a user.
+type PersonalRecords struct {
+	Items []PersonalRecord
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (p *PersonalRecords) RawJSON() json.RawMessage { return p.raw }
+
+// SetRaw sets the raw JSON response.
+func (p *PersonalRecords) SetRaw(data json.RawMessage) { p.raw = data }
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (p *PersonalRecords) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &p.Items)
+}
+
+// Find returns the record of the given type, or nil if the user has none.
+func (p *PersonalRecords) Find(t PersonalRecordType) *PersonalRecord {
+	for i := range p.Items {
+		if p.Items[i].TypeID == t {
+			return &p.Items[i]
+		}
+	}
+	return nil
+}
+
+// GetAll retrieves all personal records for the user with the given display name.
+// If displayName is empty, the current user's display name is fetched from the social profile.
+func (s *PersonalRecordsService) GetAll(ctx context.Context, displayName string) (*PersonalRecords, error) {
+	displayName, err := s.client.UserProfile.resolveDisplayName(ctx, displayName)
+	if err != nil {
+		return nil, err
+	}
+	return fetch[PersonalRecords](ctx, s.client, "/personalrecord-service/personalrecord/prs/"+url.PathEscape(displayName))
+}
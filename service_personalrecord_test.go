@@ -0,0 +1,103 @@
+// service_personalrecord_test.go
+package garmin
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestPersonalRecordsJSONUnmarshal(t *testing.T) {
+	rawJSON := `[
+		{
+			"id": 1234567890,
+			"typeId": 3,
+			"activityId": 21345678901,
+			"activityName": "Morning Run",
+			"activityType": "running",
+			"activityStartDateTimeInGMT": 1769500800000,
+			"actStartDateTimeInGMTFormatted": "2026-01-27T08:00:00.0",
+			"activityStartDateTimeLocal": 1769504400000,
+			"activityStartDateTimeLocalFormatted": "2026-01-27T09:00:00.0",
+			"value": 1325.5,
+			"prStartTimeGmt": 1769501100000,
+			"prStartTimeGmtFormatted": "2026-01-27T08:05:00.0",
+			"prStartTimeLocal": 1769504700000,
+			"prStartTimeLocalFormatted": "2026-01-27T09:05:00.0",
+			"prTypeLabelKey": null,
+			"poolLengthUnit": null
+		},
+		{
+			"id": 1234567891,
+			"typeId": 8,
+			"activityId": 21345678902,
+			"activityType": "road_biking",
+			"value": 102345.6
+		},
+		{
+			"id": 1234567892,
+			"typeId": 12,
+			"activityId": 0,
+			"activityName": null,
+			"value": 32105.0
+		}
+	]`
+
+	var records PersonalRecords
+	if err := json.Unmarshal([]byte(rawJSON), &records); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	records.SetRaw(json.RawMessage(rawJSON))
+
+	if len(records.Items) != 3 {
+		t.Fatalf("len(Items) = %d, want 3", len(records.Items))
+	}
+
+	fiveK := records.Find(PRTypeRun5K)
+	if fiveK == nil {
+		t.Fatal("expected a 5K record")
+	}
+	if fiveK.Name() != "5K" {
+		t.Errorf("Name() = %s, want 5K", fiveK.Name())
+	}
+	if d, ok := fiveK.Duration(); !ok || d != 1325500*time.Millisecond {
+		t.Errorf("Duration() = %v, %v, want 22m5.5s, true", d, ok)
+	}
+	if _, ok := fiveK.Meters(); ok {
+		t.Error("expected Meters() to be unavailable for a timed record")
+	}
+	if !fiveK.HasActivity() || fiveK.ActivityID != 21345678901 {
+		t.Errorf("ActivityID = %d, want 21345678901", fiveK.ActivityID)
+	}
+
+	ride := records.Find(PRTypeLongestRide)
+	if m, ok := ride.Meters(); !ok || m != 102345.6 {
+		t.Errorf("Meters() = %f, %v, want 102345.6, true", m, ok)
+	}
+
+	steps := records.Find(PRTypeMostStepsDay)
+	if steps.HasActivity() {
+		t.Error("expected steps record not to be linked to an activity")
+	}
+	if steps.TypeID.Unit() != PRUnitSteps {
+		t.Errorf("Unit() = %s, want %s", steps.TypeID.Unit(), PRUnitSteps)
+	}
+
+	if records.Find(PRTypeRunMarathon) != nil {
+		t.Error("expected no marathon record")
+	}
+	if string(records.RawJSON()) != rawJSON {
+		t.Error("RawJSON should return original JSON")
+	}
+}
+
+func TestPersonalRecordTypeUnknown(t *testing.T) {
+	unknown := PersonalRecordType(99)
+
+	if unknown.String() != "record type 99" {
+		t.Errorf("String() = %s, want record type 99", unknown.String())
+	}
+	if unknown.Unit() != PRUnitUnknown {
+		t.Errorf("Unit() = %s, want %s", unknown.Unit(), PRUnitUnknown)
+	}
+}
@@ -4,6 +4,7 @@ package garmin
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -14,18 +15,89 @@ type SleepService struct {
 
 // DailySleepDTO represents the inner sleep data from the API.
 type DailySleepDTO struct {
-	ID                  *int64   `json:"id"`
-	CalendarDate        string   `json:"calendarDate"`
-	SleepStartTimestamp int64    `json:"sleepStartTimestampGMT"`
-	SleepEndTimestamp   int64    `json:"sleepEndTimestampGMT"`
-	SleepSeconds        int      `json:"sleepTimeSeconds"`
-	DeepSleepSeconds    *int     `json:"deepSleepSeconds"`
-	LightSleepSeconds   *int     `json:"lightSleepSeconds"`
-	REMSleepSeconds     *int     `json:"remSleepSeconds"`
-	AwakeSeconds        *int     `json:"awakeSleepSeconds"`
-	AverageSpO2         *float64 `json:"averageSpO2Value"`
-	AwakeCount          *int     `json:"awakeCount"`
-	AvgSleepStress      *float64 `json:"avgSleepStress"`
+	ID                  *int64       `json:"id"`
+	CalendarDate        string       `json:"calendarDate"`
+	SleepStartTimestamp int64        `json:"sleepStartTimestampGMT"`
+	SleepEndTimestamp   int64        `json:"sleepEndTimestampGMT"`
+	SleepSeconds        int          `json:"sleepTimeSeconds"`
+	DeepSleepSeconds    *int         `json:"deepSleepSeconds"`
+	LightSleepSeconds   *int         `json:"lightSleepSeconds"`
+	REMSleepSeconds     *int         `json:"remSleepSeconds"`
+	AwakeSeconds        *int         `json:"awakeSleepSeconds"`
+	AverageSpO2         *float64     `json:"averageSpO2Value"`
+	AwakeCount          *int         `json:"awakeCount"`
+	AvgSleepStress      *float64     `json:"avgSleepStress"`
+	SleepScores         *SleepScores `json:"sleepScores"`
+	SleepScoreFeedback  *string      `json:"sleepScoreFeedback"`
+	SleepScoreInsight   *string      `json:"sleepScoreInsight"`
+}
+
+// SleepScoreComponent is a factor of the sleep score. Optimal and ideal ranges
+// are only set for the factors that have one.
+type SleepScoreComponent struct {
+	Value               *int     `json:"value"`
+	QualifierKey        string   `json:"qualifierKey"` // e.g. "EXCELLENT", "GOOD", "FAIR", "POOR"
+	OptimalStart        *float64 `json:"optimalStart"`
+	OptimalEnd          *float64 `json:"optimalEnd"`
+	IdealStartInSeconds *float64 `json:"idealStartInSeconds"`
+	IdealEndInSeconds   *float64 `json:"idealEndInSeconds"`
+}
+
+// SleepScores is the breakdown of the sleep score.
+type SleepScores struct {
+	Overall         *SleepScoreComponent `json:"overall"`
+	TotalDuration   *SleepScoreComponent `json:"totalDuration"`
+	Stress          *SleepScoreComponent `json:"stress"`
+	AwakeCount      *SleepScoreComponent `json:"awakeCount"`
+	REMPercentage   *SleepScoreComponent `json:"remPercentage"`
+	Restlessness    *SleepScoreComponent `json:"restlessness"`
+	LightPercentage *SleepScoreComponent `json:"lightPercentage"`
+	DeepPercentage  *SleepScoreComponent `json:"deepPercentage"`
+}
+
+// SleepStage is a stage of sleep (Garmin's sleep level).
+type SleepStage int
+
+// Sleep stages.
+const (
+	SleepStageDeep  SleepStage = 0
+	SleepStageLight SleepStage = 1
+	SleepStageREM   SleepStage = 2
+	SleepStageAwake SleepStage = 3
+)
+
+// sleepStageNames maps known sleep stages to their name.
+var sleepStageNames = map[SleepStage]string{
+	SleepStageDeep:  "deep",
+	SleepStageLight: "light",
+	SleepStageREM:   "rem",
+	SleepStageAwake: "awake",
+}
+
+// String returns the name of the sleep stage.
+func (s SleepStage) String() string {
+	if name, ok := sleepStageNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// SleepSegment is a period spent in a sleep stage.
+type SleepSegment struct {
+	Stage SleepStage `json:"stage"`
+	Start time.Time  `json:"start"`
+	End   time.Time  `json:"end"`
+}
+
+// Duration returns the duration of the segment.
+func (s *SleepSegment) Duration() time.Duration {
+	return s.End.Sub(s.Start)
+}
+
+// SleepSample is an overnight measurement (heart rate, SpO2 or respiration).
+type SleepSample struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
 }
 
 // DailySleep represents sleep data for a single day.
@@ -33,10 +105,86 @@ type DailySleep struct {
 	DailySleepDTO     DailySleepDTO `json:"dailySleepDTO"`
 	REMSleepData      bool          `json:"remSleepData"`
 	BodyBatteryChange *int          `json:"bodyBatteryChange"`
+	RestingHeartRate  *int          `json:"restingHeartRate"`
+	AvgOvernightHRV   *float64      `json:"avgOvernightHrv"`
+	HRVStatus         *string       `json:"hrvStatus"`
+
+	// Decoded from the sleep levels and the overnight series of the response.
+	Stages      []SleepSegment `json:"stages"`
+	HeartRate   []SleepSample  `json:"heartRate"`   // bpm
+	SpO2        []SleepSample  `json:"spo2"`        // percent
+	Respiration []SleepSample  `json:"respiration"` // breaths per minute
 
 	raw json.RawMessage
 }
 
+// sleepLevel is an entry of the sleep levels of the response.
+type sleepLevel struct {
+	StartGMT      string  `json:"startGMT"`
+	EndGMT        string  `json:"endGMT"`
+	ActivityLevel float64 `json:"activityLevel"`
+}
+
+// UnmarshalJSON decodes the sleep levels and overnight series of the response
+// into Stages, HeartRate, SpO2 and Respiration.
+func (d *DailySleep) UnmarshalJSON(data []byte) error {
+	type alias DailySleep
+	aux := struct {
+		*alias
+		SleepLevels []sleepLevel `json:"sleepLevels"`
+		HeartRate   []struct {
+			Value    *float64 `json:"value"`
+			StartGMT int64    `json:"startGMT"`
+		} `json:"sleepHeartRate"`
+		SpO2 []struct {
+			EpochTimestamp string   `json:"epochTimestamp"`
+			SpO2Reading    *float64 `json:"spo2Reading"`
+		} `json:"wellnessEpochSPO2DataDTOList"`
+		Respiration []struct {
+			StartTimeGMT     int64    `json:"startTimeGMT"`
+			RespirationValue *float64 `json:"respirationValue"`
+		} `json:"wellnessEpochRespirationDataDTOList"`
+	}{alias: (*alias)(d)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	d.Stages = nil
+	for _, level := range aux.SleepLevels {
+		start, err := time.Parse(wellnessTimestampFormat, level.StartGMT)
+		if err != nil {
+			continue
+		}
+		end, _ := time.Parse(wellnessTimestampFormat, level.EndGMT)
+		d.Stages = append(d.Stages, SleepSegment{Stage: SleepStage(level.ActivityLevel), Start: start, End: end})
+	}
+
+	d.HeartRate = nil
+	for _, hr := range aux.HeartRate {
+		if hr.Value != nil {
+			d.HeartRate = append(d.HeartRate, SleepSample{Time: time.UnixMilli(hr.StartGMT).UTC(), Value: *hr.Value})
+		}
+	}
+
+	d.SpO2 = nil
+	for _, spo2 := range aux.SpO2 {
+		t, err := time.Parse(wellnessTimestampFormat, spo2.EpochTimestamp)
+		if err != nil || spo2.SpO2Reading == nil {
+			continue
+		}
+		d.SpO2 = append(d.SpO2, SleepSample{Time: t, Value: *spo2.SpO2Reading})
+	}
+
+	d.Respiration = nil
+	for _, resp := range aux.Respiration {
+		// Negative values mark periods without a reading
+		if resp.RespirationValue != nil && *resp.RespirationValue >= 0 {
+			d.Respiration = append(d.Respiration, SleepSample{Time: time.UnixMilli(resp.StartTimeGMT).UTC(), Value: *resp.RespirationValue})
+		}
+	}
+	return nil
+}
+
 // RawJSON returns the original JSON response.
 func (d *DailySleep) RawJSON() json.RawMessage { return d.raw }
 
@@ -63,7 +211,90 @@ func (d *DailySleep) HasData() bool {
 	return d.DailySleepDTO.ID != nil
 }
 
+// Score returns the overall sleep score, or false if the night has none.
+func (d *DailySleep) Score() (int, bool) {
+	scores := d.DailySleepDTO.SleepScores
+	if scores == nil || scores.Overall == nil || scores.Overall.Value == nil {
+		return 0, false
+	}
+	return *scores.Overall.Value, true
+}
+
+// StageDuration returns the time spent in a sleep stage according to Stages.
+func (d *DailySleep) StageDuration(stage SleepStage) time.Duration {
+	var total time.Duration
+	for i := range d.Stages {
+		if d.Stages[i].Stage == stage {
+			total += d.Stages[i].Duration()
+		}
+	}
+	return total
+}
+
+// SleepScoreStatValues are the sleep values of a day in the sleep score stats.
+type SleepScoreStatValues struct {
+	SleepScore        *int     `json:"sleepScore"`
+	SleepScoreQuality *string  `json:"sleepScoreQuality"` // e.g. "GOOD"
+	TotalSleepTime    *int     `json:"totalSleepTime"`    // seconds
+	DeepTime          *int     `json:"deepTime"`          // seconds
+	LightTime         *int     `json:"lightTime"`         // seconds
+	REMTime           *int     `json:"remTime"`           // seconds
+	AwakeTime         *int     `json:"awakeTime"`         // seconds
+	RestingHeartRate  *int     `json:"restingHeartRate"`
+	SpO2              *float64 `json:"spO2"`
+	Respiration       *float64 `json:"respiration"`
+	BodyBatteryChange *int     `json:"bodyBatteryChange"`
+}
+
+// SleepScoreStat is the sleep score stats of a day.
+type SleepScoreStat struct {
+	CalendarDate string               `json:"calendarDate"`
+	Values       SleepScoreStatValues `json:"values"`
+}
+
+// SleepScoreOverallStats are the averages over the range of the sleep score stats.
+type SleepScoreOverallStats struct {
+	AverageSleepScore   *float64 `json:"averageSleepScore"`
+	AverageSleepSeconds *float64 `json:"averageSleepSeconds"`
+}
+
+// SleepScoreStats represents the sleep scores of a date range.
+type SleepScoreStats struct {
+	OverallStats    *SleepScoreOverallStats `json:"overallStats"`
+	IndividualStats []SleepScoreStat        `json:"individualStats"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (s *SleepScoreStats) RawJSON() json.RawMessage { return s.raw }
+
+// SetRaw sets the raw JSON response.
+func (s *SleepScoreStats) SetRaw(data json.RawMessage) { s.raw = data }
+
+// AverageScore returns the mean sleep score of the days that have one, or false
+// if none has.
+func (s *SleepScoreStats) AverageScore() (float64, bool) {
+	var mean runningMean
+	for i := range s.IndividualStats {
+		if score := s.IndividualStats[i].Values.SleepScore; score != nil {
+			mean.add(float64(*score))
+		}
+	}
+	if v := mean.value(); v != nil {
+		return *v, true
+	}
+	return 0, false
+}
+
 // GetDaily retrieves sleep data for the specified date.
 func (s *SleepService) GetDaily(ctx context.Context, date time.Time) (*DailySleep, error) {
 	return fetch[DailySleep](ctx, s.client, "/sleep-service/sleep/dailySleepData?date="+date.Format("2006-01-02"))
 }
+
+// GetScoreStats retrieves the daily sleep scores for a date range.
+func (s *SleepService) GetScoreStats(ctx context.Context, start, end time.Time) (*SleepScoreStats, error) {
+	path := fmt.Sprintf("/wellness-service/stats/daily/sleep/score/%s/%s",
+		start.Format("2006-01-02"), end.Format("2006-01-02"))
+	return fetch[SleepScoreStats](ctx, s.client, path)
+}
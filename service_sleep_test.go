@@ -2,7 +2,9 @@
 package garmin
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 	"time"
 )
@@ -120,3 +122,96 @@ func TestDailySleepOptionalSpO2(t *testing.T) {
 		t.Error("AverageSpO2 should be nil when not present")
 	}
 }
+
+func TestDailySleepTimelineJSONUnmarshal(t *testing.T) {
+	rawJSON := `{
+		"dailySleepDTO": {
+			"id": 123456789,
+			"calendarDate": "2026-01-27",
+			"sleepScores": {
+				"overall": {"value": 82, "qualifierKey": "GOOD"},
+				"remPercentage": {"value": 18, "qualifierKey": "FAIR", "optimalStart": 21.0, "optimalEnd": 31.0}
+			}
+		},
+		"sleepLevels": [
+			{"startGMT": "2026-01-26T23:00:00.0", "endGMT": "2026-01-26T23:30:00.0", "activityLevel": 1.0},
+			{"startGMT": "2026-01-26T23:30:00.0", "endGMT": "2026-01-27T00:15:00.0", "activityLevel": 0.0},
+			{"startGMT": "2026-01-27T00:15:00.0", "endGMT": "2026-01-27T00:35:00.0", "activityLevel": 2.0},
+			{"startGMT": "2026-01-27T00:35:00.0", "endGMT": "2026-01-27T00:45:00.0", "activityLevel": 1.0}
+		],
+		"sleepHeartRate": [{"value": 52, "startGMT": 1769468400000}, {"value": null, "startGMT": 1769468520000}],
+		"wellnessEpochSPO2DataDTOList": [{"epochTimestamp": "2026-01-26T23:01:00.0", "spo2Reading": 95}],
+		"wellnessEpochRespirationDataDTOList": [{"startTimeGMT": 1769468400000, "respirationValue": 14.0}, {"startTimeGMT": 1769468520000, "respirationValue": -1.0}]
+	}`
+
+	var sleep DailySleep
+	if err := json.Unmarshal([]byte(rawJSON), &sleep); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if sleep.DailySleepDTO.CalendarDate != "2026-01-27" {
+		t.Errorf("CalendarDate = %s, want 2026-01-27", sleep.DailySleepDTO.CalendarDate)
+	}
+	if score, ok := sleep.Score(); !ok || score != 82 {
+		t.Errorf("Score() = %d, %v, want 82, true", score, ok)
+	}
+	rem := sleep.DailySleepDTO.SleepScores.REMPercentage
+	if rem == nil || rem.QualifierKey != "FAIR" || rem.OptimalStart == nil || *rem.OptimalStart != 21 {
+		t.Errorf("REMPercentage = %+v, want FAIR with optimal start 21", rem)
+	}
+
+	if len(sleep.Stages) != 4 {
+		t.Fatalf("len(Stages) = %d, want 4", len(sleep.Stages))
+	}
+	deep := sleep.Stages[1]
+	if deep.Stage != SleepStageDeep || !deep.Start.Equal(time.Date(2026, 1, 26, 23, 30, 0, 0, time.UTC)) {
+		t.Errorf("Stages[1] = %+v, want deep from 23:30", deep)
+	}
+	if got := sleep.StageDuration(SleepStageLight); got != 40*time.Minute {
+		t.Errorf("StageDuration(light) = %v, want 40m", got)
+	}
+	if sleep.Stages[2].Stage.String() != "rem" {
+		t.Errorf("Stages[2].Stage = %s, want rem", sleep.Stages[2].Stage)
+	}
+
+	if len(sleep.HeartRate) != 1 || sleep.HeartRate[0].Value != 52 {
+		t.Errorf("HeartRate = %+v, want one sample at 52", sleep.HeartRate)
+	}
+	if len(sleep.SpO2) != 1 || !sleep.SpO2[0].Time.Equal(time.Date(2026, 1, 26, 23, 1, 0, 0, time.UTC)) {
+		t.Errorf("SpO2 = %+v, want one sample at 23:01", sleep.SpO2)
+	}
+	if len(sleep.Respiration) != 1 {
+		t.Errorf("len(Respiration) = %d, want 1 without the missing reading", len(sleep.Respiration))
+	}
+}
+
+func TestSleepGetScoreStats(t *testing.T) {
+	var path string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		path = req.URL.Path
+		return http.StatusOK, []byte(`{
+			"overallStats": {"averageSleepScore": 80.0},
+			"individualStats": [
+				{"calendarDate": "2026-01-26", "values": {"sleepScore": 76, "sleepScoreQuality": "FAIR", "totalSleepTime": 25200}},
+				{"calendarDate": "2026-01-27", "values": {"sleepScore": 84, "sleepScoreQuality": "GOOD"}},
+				{"calendarDate": "2026-01-28", "values": {}}
+			]
+		}`)
+	})
+
+	start := time.Date(2026, 1, 26, 0, 0, 0, 0, time.UTC)
+	stats, err := client.Sleep.GetScoreStats(context.Background(), start, start.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatalf("GetScoreStats failed: %v", err)
+	}
+
+	if path != "/wellness-service/stats/daily/sleep/score/2026-01-26/2026-01-28" {
+		t.Errorf("path = %s", path)
+	}
+	if len(stats.IndividualStats) != 3 {
+		t.Fatalf("len(IndividualStats) = %d, want 3", len(stats.IndividualStats))
+	}
+	if avg, ok := stats.AverageScore(); !ok || avg != 80 {
+		t.Errorf("AverageScore() = %v, %v, want 80, true", avg, ok)
+	}
+}
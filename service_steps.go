@@ -0,0 +1,149 @@
+// service_steps.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// stepsStatsMaxDays is the longest range the daily steps stats endpoint accepts per request.
+const stepsStatsMaxDays = 28
+
+// DailySteps represents the step count for a single day.
+type DailySteps struct {
+	CalendarDate  string   `json:"calendarDate"`
+	TotalSteps    *int     `json:"totalSteps"`
+	TotalDistance *float64 `json:"totalDistance"` // meters
+	StepGoal      *int     `json:"stepGoal"`
+}
+
+// GoalReached returns true if the step goal was reached that day.
+func (d *DailySteps) GoalReached() bool {
+	return d.TotalSteps != nil && d.StepGoal != nil && *d.StepGoal > 0 && *d.TotalSteps >= *d.StepGoal
+}
+
+// DailyStepsRange represents daily step counts over a date range.
+type DailyStepsRange struct {
+	Items []DailySteps
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (d *DailyStepsRange) RawJSON() json.RawMessage { return d.raw }
+
+// SetRaw sets the raw JSON response.
+func (d *DailyStepsRange) SetRaw(data json.RawMessage) { d.raw = data }
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (d *DailyStepsRange) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &d.Items)
+}
+
+// TotalSteps returns the sum of steps over the range.
+func (d *DailyStepsRange) TotalSteps() int {
+	total := 0
+	for i := range d.Items {
+		if d.Items[i].TotalSteps != nil {
+			total += *d.Items[i].TotalSteps
+		}
+	}
+	return total
+}
+
+// WeeklyStepsValues holds the aggregated step values for a week.
+type WeeklyStepsValues struct {
+	TotalSteps            float64 `json:"totalSteps"`
+	AverageSteps          float64 `json:"averageSteps"`
+	AverageDistance       float64 `json:"averageDistance"` // meters
+	TotalDistance         float64 `json:"totalDistance"`   // meters
+	WellnessDataDaysCount int     `json:"wellnessDataDaysCount"`
+}
+
+// WeeklySteps represents the step stats for a week starting at CalendarDate.
+type WeeklySteps struct {
+	CalendarDate string            `json:"calendarDate"`
+	Values       WeeklyStepsValues `json:"values"`
+}
+
+// WeeklyStepsRange represents weekly step stats.
+type WeeklyStepsRange struct {
+	Items []WeeklySteps
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (w *WeeklyStepsRange) RawJSON() json.RawMessage { return w.raw }
+
+// SetRaw sets the raw JSON response.
+func (w *WeeklyStepsRange) SetRaw(data json.RawMessage) { w.raw = data }
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (w *WeeklyStepsRange) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &w.Items)
+}
+
+// StepsInterval represents the steps recorded during a 15-minute interval.
+type StepsInterval struct {
+	StartGMT              string `json:"startGMT"`
+	EndGMT                string `json:"endGMT"`
+	Steps                 int    `json:"steps"`
+	Pushes                int    `json:"pushes"`
+	PrimaryActivityLevel  string `json:"primaryActivityLevel"`
+	ActivityLevelConstant bool   `json:"activityLevelConstant"`
+}
+
+// IntradaySteps represents the steps of a day in 15-minute intervals.
+type IntradaySteps struct {
+	Items []StepsInterval
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (i *IntradaySteps) RawJSON() json.RawMessage { return i.raw }
+
+// SetRaw sets the raw JSON response.
+func (i *IntradaySteps) SetRaw(data json.RawMessage) { i.raw = data }
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (i *IntradaySteps) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &i.Items)
+}
+
+// TotalSteps returns the sum of steps over all intervals.
+func (i *IntradaySteps) TotalSteps() int {
+	total := 0
+	for _, interval := range i.Items {
+		total += interval.Steps
+	}
+	return total
+}
+
+// GetDaily retrieves daily step counts for a date range. Ranges longer than
+// 28 days are split into several requests and merged.
+func (s *StepsService) GetDaily(ctx context.Context, start, end time.Time) (*DailyStepsRange, error) {
+	return fetchDateRange[DailyStepsRange](ctx, s.client, start, end, stepsStatsMaxDays, func(start, end time.Time) string {
+		return fmt.Sprintf("/usersummary-service/stats/steps/daily/%s/%s",
+			start.Format("2006-01-02"), end.Format("2006-01-02"))
+	})
+}
+
+// GetWeekly retrieves step stats for the given number of weeks ending at end.
+func (s *StepsService) GetWeekly(ctx context.Context, end time.Time, weeks int) (*WeeklyStepsRange, error) {
+	path := fmt.Sprintf("/usersummary-service/stats/steps/weekly/%s/%d", end.Format("2006-01-02"), weeks)
+	return fetch[WeeklyStepsRange](ctx, s.client, path)
+}
+
+// GetIntraday retrieves the steps of a day in 15-minute intervals.
+// If displayName is empty, the current user's display name is fetched from the social profile.
+func (s *StepsService) GetIntraday(ctx context.Context, displayName string, date time.Time) (*IntradaySteps, error) {
+	displayName, err := s.client.UserProfile.resolveDisplayName(ctx, displayName)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/wellness-service/wellness/dailySummaryChart/%s?date=%s",
+		url.PathEscape(displayName), date.Format("2006-01-02"))
+	return fetch[IntradaySteps](ctx, s.client, path)
+}
@@ -0,0 +1,138 @@
+// service_steps_test.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/llehouerou/go-garmin/testutil"
+)
+
+func TestDailyStepsRangeJSONUnmarshal(t *testing.T) {
+	rawJSON := `[
+		{"calendarDate": "2026-01-26", "totalSteps": 12450, "totalDistance": 9870.0, "stepGoal": 10000},
+		{"calendarDate": "2026-01-27", "totalSteps": 6200, "totalDistance": 4910.0, "stepGoal": 10000},
+		{"calendarDate": "2026-01-28", "totalSteps": null, "totalDistance": null, "stepGoal": 10000}
+	]`
+
+	var steps DailyStepsRange
+	if err := json.Unmarshal([]byte(rawJSON), &steps); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if len(steps.Items) != 3 {
+		t.Fatalf("len(Items) = %d, want 3", len(steps.Items))
+	}
+	if !steps.Items[0].GoalReached() {
+		t.Error("expected goal to be reached on 2026-01-26")
+	}
+	if steps.Items[1].GoalReached() || steps.Items[2].GoalReached() {
+		t.Error("expected goal not to be reached on 2026-01-27 and 2026-01-28")
+	}
+	if got := steps.TotalSteps(); got != 18650 {
+		t.Errorf("TotalSteps() = %d, want 18650", got)
+	}
+}
+
+func TestWeeklyStepsRangeJSONUnmarshal(t *testing.T) {
+	rawJSON := `[
+		{
+			"calendarDate": "2026-01-21",
+			"values": {
+				"totalSteps": 63210.0,
+				"averageSteps": 9030.0,
+				"averageDistance": 7150.5,
+				"totalDistance": 50053.5,
+				"wellnessDataDaysCount": 7
+			}
+		}
+	]`
+
+	var weekly WeeklyStepsRange
+	if err := json.Unmarshal([]byte(rawJSON), &weekly); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if len(weekly.Items) != 1 {
+		t.Fatalf("len(Items) = %d, want 1", len(weekly.Items))
+	}
+	if weekly.Items[0].Values.AverageSteps != 9030 {
+		t.Errorf("AverageSteps = %f, want 9030", weekly.Items[0].Values.AverageSteps)
+	}
+	if weekly.Items[0].Values.WellnessDataDaysCount != 7 {
+		t.Errorf("WellnessDataDaysCount = %d, want 7", weekly.Items[0].Values.WellnessDataDaysCount)
+	}
+}
+
+func TestIntradayStepsJSONUnmarshal(t *testing.T) {
+	rawJSON := `[
+		{"startGMT": "2026-01-27T07:00:00.0", "endGMT": "2026-01-27T07:15:00.0", "steps": 0, "pushes": 0, "primaryActivityLevel": "sleeping", "activityLevelConstant": true},
+		{"startGMT": "2026-01-27T07:15:00.0", "endGMT": "2026-01-27T07:30:00.0", "steps": 412, "pushes": 0, "primaryActivityLevel": "active", "activityLevelConstant": false},
+		{"startGMT": "2026-01-27T07:30:00.0", "endGMT": "2026-01-27T07:45:00.0", "steps": 1588, "pushes": 0, "primaryActivityLevel": "highlyActive", "activityLevelConstant": false}
+	]`
+
+	var intraday IntradaySteps
+	if err := json.Unmarshal([]byte(rawJSON), &intraday); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if len(intraday.Items) != 3 {
+		t.Fatalf("len(Items) = %d, want 3", len(intraday.Items))
+	}
+	if intraday.Items[2].PrimaryActivityLevel != "highlyActive" {
+		t.Errorf("PrimaryActivityLevel = %s, want highlyActive", intraday.Items[2].PrimaryActivityLevel)
+	}
+	if got := intraday.TotalSteps(); got != 2000 {
+		t.Errorf("TotalSteps() = %d, want 2000", got)
+	}
+}
+
+func TestStepsGetDailyChunksRange(t *testing.T) {
+	var paths []string
+	client := New(Options{HTTPClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		// Respond with one entry per requested chunk, dated at its start
+		parts := strings.Split(req.URL.Path, "/")
+		body := `[{"calendarDate": "` + parts[len(parts)-2] + `", "totalSteps": 1000, "stepGoal": 10000}]`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	})}})
+	if err := client.LoadSession(strings.NewReader(testutil.FakeSessionJSON())); err != nil {
+		t.Fatalf("failed to load fake session: %v", err)
+	}
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	steps, err := client.Steps.GetDaily(context.Background(), start, end)
+	if err != nil {
+		t.Fatalf("GetDaily failed: %v", err)
+	}
+
+	wantPaths := []string{
+		"/usersummary-service/stats/steps/daily/2026-01-01/2026-01-28",
+		"/usersummary-service/stats/steps/daily/2026-01-29/2026-02-25",
+		"/usersummary-service/stats/steps/daily/2026-02-26/2026-03-01",
+	}
+	if strings.Join(paths, ",") != strings.Join(wantPaths, ",") {
+		t.Errorf("requested paths = %v, want %v", paths, wantPaths)
+	}
+	if len(steps.Items) != 3 || steps.Items[2].CalendarDate != "2026-02-26" {
+		t.Errorf("merged items = %+v, want one entry per chunk", steps.Items)
+	}
+	if steps.TotalSteps() != 3000 {
+		t.Errorf("TotalSteps() = %d, want 3000", steps.TotalSteps())
+	}
+	if !strings.HasPrefix(string(steps.RawJSON()), "[") {
+		t.Errorf("RawJSON() = %s, want merged JSON array", steps.RawJSON())
+	}
+}
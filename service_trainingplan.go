@@ -0,0 +1,315 @@
+// service_trainingplan.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Training plan categories.
+const (
+	TrainingPlanCategoryPhased   = "PHASED"
+	TrainingPlanCategoryAdaptive = "FBT_ADAPTIVE"
+)
+
+// TrainingPlanType is the sport of a training plan.
+type TrainingPlanType struct {
+	TypeID  int    `json:"typeId"`
+	TypeKey string `json:"typeKey"` // e.g. "running", "cycling"
+}
+
+// TrainingPlanLevel is the difficulty level of a training plan.
+type TrainingPlanLevel struct {
+	LevelID  int    `json:"levelId"`
+	LevelKey string `json:"levelKey"` // e.g. "beginner", "intermediate"
+}
+
+// TrainingPlanStatus is the status of a training plan for the user.
+type TrainingPlanStatus struct {
+	StatusID  int    `json:"statusId"`
+	StatusKey string `json:"statusKey"` // e.g. "Scheduled", "Completed"
+}
+
+// TrainingPlanSummary represents a training plan in the plan list.
+type TrainingPlanSummary struct {
+	TrainingPlanID       int64               `json:"trainingPlanId"`
+	Name                 string              `json:"name"`
+	Description          *string             `json:"description"`
+	TrainingPlanCategory string              `json:"trainingPlanCategory"`
+	TrainingType         *TrainingPlanType   `json:"trainingType"`
+	TrainingLevel        *TrainingPlanLevel  `json:"trainingLevel"`
+	TrainingStatus       *TrainingPlanStatus `json:"trainingStatus"`
+	StartDate            *string             `json:"startDate"`
+	EndDate              *string             `json:"endDate"`
+	DurationInWeeks      *int                `json:"durationInWeeks"`
+	AvgWeeklyWorkouts    *float64            `json:"avgWeeklyWorkouts"`
+}
+
+// IsAdaptive returns true if the plan is an adaptive (Garmin Coach) plan, which is
+// fetched with GetAdaptive rather than GetPhased.
+func (t *TrainingPlanSummary) IsAdaptive() bool {
+	return strings.Contains(t.TrainingPlanCategory, "ADAPTIVE")
+}
+
+// TrainingPlanList represents the training plans of the user.
+type TrainingPlanList struct {
+	TrainingPlanList []TrainingPlanSummary `json:"trainingPlanList"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (t *TrainingPlanList) RawJSON() json.RawMessage { return t.raw }
+
+// SetRaw sets the raw JSON response.
+func (t *TrainingPlanList) SetRaw(data json.RawMessage) { t.raw = data }
+
+// Find returns the plan with the given ID, or nil if the user has no such plan.
+func (t *TrainingPlanList) Find(planID int64) *TrainingPlanSummary {
+	for i := range t.TrainingPlanList {
+		if t.TrainingPlanList[i].TrainingPlanID == planID {
+			return &t.TrainingPlanList[i]
+		}
+	}
+	return nil
+}
+
+// TrainingPlanTaskWorkout is the workout planned for a training plan task.
+type TrainingPlanTaskWorkout struct {
+	WorkoutID                     *int64     `json:"workoutId"`
+	WorkoutUUID                   *string    `json:"workoutUuid"`
+	WorkoutName                   *string    `json:"workoutName"`
+	WorkoutDescription            *string    `json:"workoutDescription"`
+	SportType                     *SportType `json:"sportType"`
+	EstimatedDurationInSecs       *int       `json:"estimatedDurationInSecs"`
+	EstimatedDistanceInMeters     *float64   `json:"estimatedDistanceInMeters"`
+	RestDay                       bool       `json:"restDay"`
+	WorkoutPhrase                 *string    `json:"workoutPhrase"`
+	AdaptiveCoachingWorkoutStatus *string    `json:"adaptiveCoachingWorkoutStatus"`
+}
+
+// TrainingPlanTask is a day of a training plan.
+type TrainingPlanTask struct {
+	CalendarDate string                   `json:"calendarDate"` // YYYY-MM-DD
+	WeekID       int                      `json:"weekId"`
+	DayOfWeekID  int                      `json:"dayOfWeekId"`
+	TaskWorkout  *TrainingPlanTaskWorkout `json:"taskWorkout"`
+}
+
+// Date returns the calendar date of the task.
+func (t *TrainingPlanTask) Date() time.Time {
+	d, _ := time.Parse("2006-01-02", t.CalendarDate)
+	return d
+}
+
+// IsRestDay returns true if no workout is planned for the task.
+func (t *TrainingPlanTask) IsRestDay() bool {
+	return t.TaskWorkout == nil || t.TaskWorkout.RestDay
+}
+
+// TrainingPhase is a phase of a training plan (e.g. base, build, peak, taper).
+type TrainingPhase struct {
+	StartDate     string `json:"startDate"`
+	EndDate       string `json:"endDate"`
+	TrainingPhase string `json:"trainingPhase"`
+	CurrentPhase  bool   `json:"currentPhase"`
+}
+
+// TrainingPlan represents a phased or adaptive training plan with its tasks.
+type TrainingPlan struct {
+	TrainingPlanSummary
+	TaskList       []TrainingPlanTask `json:"taskList"`
+	TrainingPhases []TrainingPhase    `json:"trainingPhases"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (t *TrainingPlan) RawJSON() json.RawMessage { return t.raw }
+
+// SetRaw sets the raw JSON response.
+func (t *TrainingPlan) SetRaw(data json.RawMessage) { t.raw = data }
+
+// CurrentPhase returns the current phase of the plan, or nil if unknown.
+func (t *TrainingPlan) CurrentPhase() *TrainingPhase {
+	for i := range t.TrainingPhases {
+		if t.TrainingPhases[i].CurrentPhase {
+			return &t.TrainingPhases[i]
+		}
+	}
+	return nil
+}
+
+// ScheduledTask is a training plan task with the calendar entry and workout it is scheduled as.
+type ScheduledTask struct {
+	TrainingPlanTask
+	CalendarItem *CalendarItem `json:"calendarItem,omitempty"` // nil if not on the calendar
+	Workout      *Workout      `json:"workout,omitempty"`      // nil for rest days or if not fetched
+}
+
+// TrainingPlanSchedule represents the upcoming schedule of a training plan.
+type TrainingPlanSchedule struct {
+	Plan  *TrainingPlan   `json:"plan"`
+	Tasks []ScheduledTask `json:"tasks"`
+}
+
+// TrainingPlanScheduleOptions configures GetSchedule.
+type TrainingPlanScheduleOptions struct {
+	// From is the first date of the schedule (defaults to today).
+	From time.Time
+	// IncludeWorkouts fetches the full workout of each task. This makes one request
+	// per distinct workout.
+	IncludeWorkouts bool
+}
+
+// List retrieves the training plans of the user.
+func (s *TrainingPlanService) List(ctx context.Context) (*TrainingPlanList, error) {
+	return fetch[TrainingPlanList](ctx, s.client, "/trainingplan-service/trainingplan/plans")
+}
+
+// GetPhased retrieves a phased training plan with its tasks.
+func (s *TrainingPlanService) GetPhased(ctx context.Context, planID int64) (*TrainingPlan, error) {
+	return fetch[TrainingPlan](ctx, s.client, fmt.Sprintf("/trainingplan-service/trainingplan/phased/%d", planID))
+}
+
+// GetAdaptive retrieves an adaptive (Garmin Coach) training plan with its tasks.
+func (s *TrainingPlanService) GetAdaptive(ctx context.Context, planID int64) (*TrainingPlan, error) {
+	return fetch[TrainingPlan](ctx, s.client, fmt.Sprintf("/trainingplan-service/trainingplan/fbt-adaptive/%d", planID))
+}
+
+// GetSchedule retrieves the schedule of a training plan from opts.From onwards.
+// Each task is linked to its calendar entry and, with opts.IncludeWorkouts, to its
+// full workout. The plan is fetched as phased or adaptive depending on its category.
+func (s *TrainingPlanService) GetSchedule(ctx context.Context, planID int64, opts *TrainingPlanScheduleOptions) (*TrainingPlanSchedule, error) {
+	if opts == nil {
+		opts = &TrainingPlanScheduleOptions{}
+	}
+	from := opts.From
+	if from.IsZero() {
+		from = time.Now()
+	}
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+
+	plans, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summary := plans.Find(planID)
+	if summary == nil {
+		return nil, fmt.Errorf("training plan %d: %w", planID, ErrNotFound)
+	}
+
+	get := s.GetPhased
+	if summary.IsAdaptive() {
+		get = s.GetAdaptive
+	}
+	plan, err := get(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &TrainingPlanSchedule{Plan: plan}
+	for _, task := range plan.TaskList {
+		if !task.Date().Before(from) {
+			schedule.Tasks = append(schedule.Tasks, ScheduledTask{TrainingPlanTask: task})
+		}
+	}
+
+	if err := s.linkCalendarItems(ctx, planID, schedule.Tasks); err != nil {
+		return nil, err
+	}
+	if opts.IncludeWorkouts {
+		if err := s.linkWorkouts(ctx, schedule.Tasks); err != nil {
+			return nil, err
+		}
+	}
+	return schedule, nil
+}
+
+// linkCalendarItems links tasks to the calendar items of the plan, fetching each month once.
+func (s *TrainingPlanService) linkCalendarItems(ctx context.Context, planID int64, tasks []ScheduledTask) error {
+	itemsByDate := make(map[string][]CalendarItem)
+	fetched := make(map[string]bool)
+
+	for i := range tasks {
+		date := tasks[i].Date()
+		month := date.Format("2006-01")
+		if !fetched[month] {
+			fetched[month] = true
+			m := int(date.Month()) - 1 // calendar months are 0-based
+			calendar, err := s.client.Calendar.Get(ctx, date.Year(), &CalendarOptions{Month: &m})
+			if err != nil {
+				return fmt.Errorf("get calendar for %s: %w", month, err)
+			}
+			for _, item := range calendar.CalendarItems {
+				if belongsToPlan(&item, planID) {
+					itemsByDate[item.Date] = append(itemsByDate[item.Date], item)
+				}
+			}
+		}
+		tasks[i].CalendarItem = matchCalendarItem(itemsByDate[tasks[i].CalendarDate], tasks[i].TaskWorkout)
+	}
+	return nil
+}
+
+// linkWorkouts fetches the workout of each task, fetching each workout once.
+func (s *TrainingPlanService) linkWorkouts(ctx context.Context, tasks []ScheduledTask) error {
+	workouts := make(map[int64]*Workout)
+	for i := range tasks {
+		workoutID := scheduledWorkoutID(&tasks[i])
+		if workoutID == 0 {
+			continue
+		}
+		if _, ok := workouts[workoutID]; !ok {
+			workout, err := s.client.Workouts.Get(ctx, workoutID)
+			if err != nil {
+				return fmt.Errorf("get workout %d: %w", workoutID, err)
+			}
+			workouts[workoutID] = workout
+		}
+		tasks[i].Workout = workouts[workoutID]
+	}
+	return nil
+}
+
+// belongsToPlan returns true if the calendar item was scheduled by the training plan.
+func belongsToPlan(item *CalendarItem, planID int64) bool {
+	return (item.TrainingPlanID != nil && *item.TrainingPlanID == planID) ||
+		(item.AtpPlanID != nil && *item.AtpPlanID == planID)
+}
+
+// matchCalendarItem returns the calendar item of a day matching the task workout,
+// falling back to the only item of the day.
+func matchCalendarItem(items []CalendarItem, workout *TrainingPlanTaskWorkout) *CalendarItem {
+	if workout != nil {
+		for i := range items {
+			if workout.WorkoutUUID != nil && items[i].WorkoutUUID != nil && *items[i].WorkoutUUID == *workout.WorkoutUUID {
+				return &items[i]
+			}
+			if workout.WorkoutID != nil && items[i].WorkoutID != nil && *items[i].WorkoutID == *workout.WorkoutID {
+				return &items[i]
+			}
+		}
+	}
+	if len(items) == 1 {
+		return &items[0]
+	}
+	return nil
+}
+
+// scheduledWorkoutID returns the ID of the workout of a task, preferring the calendar item.
+func scheduledWorkoutID(task *ScheduledTask) int64 {
+	if task.IsRestDay() {
+		return 0
+	}
+	if task.CalendarItem != nil && task.CalendarItem.WorkoutID != nil {
+		return *task.CalendarItem.WorkoutID
+	}
+	if task.TaskWorkout.WorkoutID != nil {
+		return *task.TaskWorkout.WorkoutID
+	}
+	return 0
+}
@@ -0,0 +1,159 @@
+// service_trainingplan_test.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const testPhasedPlanJSON = `{
+	"trainingPlanId": 101,
+	"name": "Half Marathon - Intermediate",
+	"trainingPlanCategory": "PHASED",
+	"trainingType": {"typeId": 1, "typeKey": "running"},
+	"trainingLevel": {"levelId": 2, "levelKey": "intermediate"},
+	"startDate": "2026-01-05",
+	"endDate": "2026-03-29",
+	"durationInWeeks": 12,
+	"trainingPhases": [
+		{"startDate": "2026-01-05", "endDate": "2026-02-01", "trainingPhase": "BASE", "currentPhase": false},
+		{"startDate": "2026-02-02", "endDate": "2026-03-01", "trainingPhase": "BUILD", "currentPhase": true}
+	],
+	"taskList": [
+		{"calendarDate": "2026-01-26", "weekId": 4, "dayOfWeekId": 1,
+			"taskWorkout": {"workoutId": 900, "workoutName": "Easy Run", "restDay": false}},
+		{"calendarDate": "2026-02-02", "weekId": 5, "dayOfWeekId": 1,
+			"taskWorkout": {"workoutId": 901, "workoutName": "Tempo Run", "restDay": false}},
+		{"calendarDate": "2026-02-03", "weekId": 5, "dayOfWeekId": 2,
+			"taskWorkout": {"restDay": true}},
+		{"calendarDate": "2026-03-02", "weekId": 9, "dayOfWeekId": 1,
+			"taskWorkout": {"workoutId": 901, "workoutName": "Tempo Run", "restDay": false}}
+	]
+}`
+
+func TestTrainingPlanJSONUnmarshal(t *testing.T) {
+	var plan TrainingPlan
+	if err := json.Unmarshal([]byte(testPhasedPlanJSON), &plan); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	if plan.TrainingPlanID != 101 || plan.Name != "Half Marathon - Intermediate" {
+		t.Errorf("plan = %d %q, want 101 %q", plan.TrainingPlanID, plan.Name, "Half Marathon - Intermediate")
+	}
+	if plan.IsAdaptive() {
+		t.Error("expected phased plan not to be adaptive")
+	}
+	if plan.TrainingType == nil || plan.TrainingType.TypeKey != "running" {
+		t.Errorf("TrainingType = %+v, want running", plan.TrainingType)
+	}
+	if phase := plan.CurrentPhase(); phase == nil || phase.TrainingPhase != "BUILD" {
+		t.Errorf("CurrentPhase() = %+v, want BUILD", phase)
+	}
+	if len(plan.TaskList) != 4 {
+		t.Fatalf("len(TaskList) = %d, want 4", len(plan.TaskList))
+	}
+	if !plan.TaskList[2].IsRestDay() || plan.TaskList[1].IsRestDay() {
+		t.Error("expected only the third task to be a rest day")
+	}
+	if got := plan.TaskList[1].Date(); !got.Equal(time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Date() = %v, want 2026-02-02", got)
+	}
+}
+
+func TestTrainingPlanSummaryIsAdaptive(t *testing.T) {
+	tests := []struct {
+		category string
+		want     bool
+	}{
+		{TrainingPlanCategoryPhased, false},
+		{TrainingPlanCategoryAdaptive, true},
+		{"", false},
+	}
+	for _, tt := range tests {
+		s := TrainingPlanSummary{TrainingPlanCategory: tt.category}
+		if got := s.IsAdaptive(); got != tt.want {
+			t.Errorf("IsAdaptive(%q) = %v, want %v", tt.category, got, tt.want)
+		}
+	}
+}
+
+func TestTrainingPlanGetSchedule(t *testing.T) {
+	requests := make(map[string]int)
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		requests[req.URL.Path]++
+		switch req.URL.Path {
+		case "/trainingplan-service/trainingplan/plans":
+			return http.StatusOK, []byte(`{"trainingPlanList": [{"trainingPlanId": 101, "name": "Half Marathon", "trainingPlanCategory": "PHASED"}]}`)
+		case "/trainingplan-service/trainingplan/phased/101":
+			return http.StatusOK, []byte(testPhasedPlanJSON)
+		case "/calendar-service/year/2026/month/1":
+			return http.StatusOK, []byte(`{"calendarItems": [
+				{"id": 1, "itemType": "workout", "date": "2026-02-02", "workoutId": 9011, "trainingPlanId": 101},
+				{"id": 2, "itemType": "workout", "date": "2026-02-02", "workoutId": 500},
+				{"id": 3, "itemType": "activity", "date": "2026-02-03"}
+			]}`)
+		case "/calendar-service/year/2026/month/2":
+			return http.StatusOK, []byte(`{"calendarItems": []}`)
+		case "/workout-service/workout/9011":
+			return http.StatusOK, []byte(`{"workoutId": 9011, "workoutName": "Tempo Run"}`)
+		case "/workout-service/workout/901":
+			return http.StatusOK, []byte(`{"workoutId": 901, "workoutName": "Tempo Run"}`)
+		default:
+			t.Errorf("unexpected request: %s", req.URL.Path)
+			return http.StatusNotFound, nil
+		}
+	})
+
+	schedule, err := client.TrainingPlans.GetSchedule(context.Background(), 101, &TrainingPlanScheduleOptions{
+		From:            time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		IncludeWorkouts: true,
+	})
+	if err != nil {
+		t.Fatalf("GetSchedule failed: %v", err)
+	}
+
+	if len(schedule.Tasks) != 3 {
+		t.Fatalf("len(Tasks) = %d, want 3 tasks from 2026-02-01", len(schedule.Tasks))
+	}
+
+	tempo := schedule.Tasks[0]
+	if tempo.CalendarItem == nil || tempo.CalendarItem.ID != 1 {
+		t.Errorf("Tasks[0].CalendarItem = %+v, want calendar item 1 of the plan", tempo.CalendarItem)
+	}
+	// The scheduled copy of the workout is preferred over the plan template
+	if tempo.Workout == nil || tempo.Workout.WorkoutID != 9011 {
+		t.Errorf("Tasks[0].Workout = %+v, want workout 9011", tempo.Workout)
+	}
+
+	rest := schedule.Tasks[1]
+	if rest.CalendarItem != nil || rest.Workout != nil {
+		t.Errorf("rest day should not be linked, got %+v", rest)
+	}
+
+	unscheduled := schedule.Tasks[2]
+	if unscheduled.CalendarItem != nil {
+		t.Errorf("Tasks[2].CalendarItem = %+v, want nil", unscheduled.CalendarItem)
+	}
+	if unscheduled.Workout == nil || unscheduled.Workout.WorkoutID != 901 {
+		t.Errorf("Tasks[2].Workout = %+v, want plan workout 901", unscheduled.Workout)
+	}
+
+	if requests["/calendar-service/year/2026/month/1"] != 1 {
+		t.Errorf("February calendar fetched %d times, want 1", requests["/calendar-service/year/2026/month/1"])
+	}
+}
+
+func TestTrainingPlanGetScheduleUnknownPlan(t *testing.T) {
+	client := newFakeClient(t, func(*http.Request) (int, []byte) {
+		return http.StatusOK, []byte(`{"trainingPlanList": []}`)
+	})
+
+	_, err := client.TrainingPlans.GetSchedule(context.Background(), 7, nil)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetSchedule error = %v, want ErrNotFound", err)
+	}
+}
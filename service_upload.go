@@ -0,0 +1,228 @@
+// service_upload.go
+package garmin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// UploadFormat identifies the file format of an activity upload.
+type UploadFormat string
+
+const (
+	UploadFormatFIT UploadFormat = "fit"
+	UploadFormatTCX UploadFormat = "tcx"
+	UploadFormatGPX UploadFormat = "gpx"
+)
+
+// uploadPollInterval is the delay between upload status checks.
+var uploadPollInterval = 2 * time.Second
+
+// uploadMaxPolls bounds the number of status checks made while waiting for processing.
+const uploadMaxPolls = 30
+
+// uploadSniffSize is the number of leading bytes inspected to detect the file format.
+const uploadSniffSize = 512
+
+// DetectUploadFormat determines the activity file format from the file name
+// extension, falling back to the file content header.
+// Returns an empty UploadFormat if the format is not recognized.
+func DetectUploadFormat(fileName string, header []byte) UploadFormat {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".fit":
+		return UploadFormatFIT
+	case ".tcx":
+		return UploadFormatTCX
+	case ".gpx":
+		return UploadFormatGPX
+	}
+
+	// FIT files carry the ".FIT" signature at bytes 8-11 of the header.
+	if len(header) >= 12 && string(header[8:12]) == ".FIT" {
+		return UploadFormatFIT
+	}
+	if bytes.Contains(header, []byte("<TrainingCenterDatabase")) {
+		return UploadFormatTCX
+	}
+	if bytes.Contains(header, []byte("<gpx")) {
+		return UploadFormatGPX
+	}
+
+	return ""
+}
+
+// UploadMessage represents a message attached to an upload success or failure.
+type UploadMessage struct {
+	Code    int    `json:"code"`
+	Content string `json:"content"`
+}
+
+// UploadEntry represents a single imported (or rejected) activity in an upload.
+type UploadEntry struct {
+	InternalID *int64          `json:"internalId"`
+	ExternalID *string         `json:"externalId"`
+	Messages   []UploadMessage `json:"messages"`
+}
+
+// UploadUUID wraps the unique identifier of an upload.
+type UploadUUID struct {
+	UUID string `json:"uuid"`
+}
+
+// DetailedImportResult represents the processing result of an uploaded file.
+type DetailedImportResult struct {
+	UploadID       int64         `json:"uploadId"`
+	UploadUUID     *UploadUUID   `json:"uploadUuid"`
+	Owner          int64         `json:"owner"`
+	FileSize       int64         `json:"fileSize"`
+	ProcessingTime int64         `json:"processingTime"`
+	CreationDate   string        `json:"creationDate"`
+	FileName       string        `json:"fileName"`
+	Successes      []UploadEntry `json:"successes"`
+	Failures       []UploadEntry `json:"failures"`
+}
+
+// UploadResult represents the response of an activity upload.
+type UploadResult struct {
+	DetailedImportResult DetailedImportResult `json:"detailedImportResult"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (r *UploadResult) RawJSON() json.RawMessage {
+	return r.raw
+}
+
+// SetRaw sets the raw JSON response.
+func (r *UploadResult) SetRaw(data json.RawMessage) {
+	r.raw = data
+}
+
+// ActivityIDs returns the IDs of the activities created by the upload.
+func (r *UploadResult) ActivityIDs() []int64 {
+	ids := make([]int64, 0, len(r.DetailedImportResult.Successes))
+	for _, s := range r.DetailedImportResult.Successes {
+		if s.InternalID != nil {
+			ids = append(ids, *s.InternalID)
+		}
+	}
+	return ids
+}
+
+// Pending returns true if the upload has not finished processing yet.
+func (r *UploadResult) Pending() bool {
+	return len(r.DetailedImportResult.Successes) == 0 && len(r.DetailedImportResult.Failures) == 0
+}
+
+// uploadCreationMillis converts an upload creation date (e.g. "2026-01-27 08:15:42.0 GMT")
+// to Unix milliseconds, as expected by the upload status endpoint.
+// Any number of fractional second digits is accepted.
+func uploadCreationMillis(creationDate string) (int64, error) {
+	t, err := time.Parse("2006-01-02 15:04:05 MST", creationDate)
+	if err != nil {
+		return 0, fmt.Errorf("parse upload creation date: %w", err)
+	}
+	return t.UnixMilli(), nil
+}
+
+// UploadActivity uploads a FIT, TCX or GPX activity file and waits until Garmin
+// has finished processing it.
+// The format is detected from the file name extension or, failing that, from the content;
+// the matching extension is appended to fileName if missing.
+// Returns an error wrapping ErrDuplicateUpload if the activity was already uploaded.
+// If processing has not finished after the polling budget, the pending result is returned.
+func (s *UploadService) UploadActivity(ctx context.Context, fileName string, content io.Reader) (*UploadResult, error) {
+	br := bufio.NewReaderSize(content, uploadSniffSize)
+	header, err := br.Peek(uploadSniffSize)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, fmt.Errorf("read upload header: %w", err)
+	}
+
+	format := DetectUploadFormat(fileName, header)
+	if format == "" {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedUploadFormat, fileName)
+	}
+	if !strings.EqualFold(filepath.Ext(fileName), "."+string(format)) {
+		fileName += "." + string(format)
+	}
+
+	result, err := upload[UploadResult](ctx, s.client, "/upload-service/upload", "file", fileName, br)
+	if err != nil {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+			return nil, fmt.Errorf("%w: %w", ErrDuplicateUpload, err)
+		}
+		return nil, err
+	}
+
+	for attempt := 0; result.Pending() && attempt < uploadMaxPolls; attempt++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(uploadPollInterval):
+		}
+
+		result, err = s.GetStatus(ctx, result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// GetStatus checks the processing status of a previous upload.
+// The returned result is still pending while Garmin is processing the file.
+func (s *UploadService) GetStatus(ctx context.Context, result *UploadResult) (*UploadResult, error) {
+	if result.DetailedImportResult.UploadUUID == nil {
+		return nil, errors.New("upload result has no upload UUID")
+	}
+	millis, err := uploadCreationMillis(result.DetailedImportResult.CreationDate)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/activity-service/activity/status/%d/%s", millis, result.DetailedImportResult.UploadUUID.UUID)
+
+	resp, err := s.client.doAPI(ctx, http.MethodGet, path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusConflict {
+		return nil, fmt.Errorf("%w: %w", ErrDuplicateUpload, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Body: body})
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, Body: body}
+	}
+
+	// Still processing
+	if resp.StatusCode == http.StatusAccepted || len(body) == 0 {
+		return result, nil
+	}
+
+	var status UploadResult
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, fmt.Errorf("unmarshal upload status: %w", err)
+	}
+	status.raw = body
+
+	return &status, nil
+}
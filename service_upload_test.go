@@ -0,0 +1,198 @@
+// service_upload_test.go
+package garmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDetectUploadFormat(t *testing.T) {
+	fitHeader := []byte{14, 16, 0x5c, 0x08, 0, 0, 0, 0, '.', 'F', 'I', 'T', 0, 0}
+
+	tests := []struct {
+		name     string
+		fileName string
+		header   []byte
+		want     UploadFormat
+	}{
+		{"fit extension", "morning_run.fit", nil, UploadFormatFIT},
+		{"uppercase extension", "MORNING_RUN.FIT", nil, UploadFormatFIT},
+		{"tcx extension", "ride.tcx", nil, UploadFormatTCX},
+		{"gpx extension", "hike.gpx", nil, UploadFormatGPX},
+		{"fit signature", "activity", fitHeader, UploadFormatFIT},
+		{"tcx content", "activity", []byte(`<?xml version="1.0"?><TrainingCenterDatabase xmlns="...">`), UploadFormatTCX},
+		{"gpx content", "activity.xml", []byte(`<?xml version="1.0"?><gpx version="1.1">`), UploadFormatGPX},
+		{"unknown", "notes.txt", []byte("hello"), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectUploadFormat(tt.fileName, tt.header); got != tt.want {
+				t.Errorf("DetectUploadFormat(%q) = %q, want %q", tt.fileName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUploadResultJSONUnmarshal(t *testing.T) {
+	rawJSON := `{
+		"detailedImportResult": {
+			"uploadId": 292735591,
+			"uploadUuid": {"uuid": "6c1f2a9e-0d5b-4f4e-9d8e-5b3f1c2a7e90"},
+			"owner": 12345678,
+			"fileSize": 48213,
+			"processingTime": 61,
+			"creationDate": "2026-01-27 08:15:42.0 GMT",
+			"fileName": "morning_run.fit",
+			"successes": [
+				{"internalId": 21680374805, "externalId": "1106313502", "messages": null}
+			],
+			"failures": []
+		}
+	}`
+
+	var result UploadResult
+	if err := json.Unmarshal([]byte(rawJSON), &result); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+
+	d := result.DetailedImportResult
+	if d.UploadID != 292735591 {
+		t.Errorf("UploadID = %d, want 292735591", d.UploadID)
+	}
+	if d.UploadUUID == nil || d.UploadUUID.UUID != "6c1f2a9e-0d5b-4f4e-9d8e-5b3f1c2a7e90" {
+		t.Errorf("UploadUUID = %v, want 6c1f2a9e-0d5b-4f4e-9d8e-5b3f1c2a7e90", d.UploadUUID)
+	}
+	if result.Pending() {
+		t.Error("Pending() = true, want false")
+	}
+
+	ids := result.ActivityIDs()
+	if len(ids) != 1 || ids[0] != 21680374805 {
+		t.Errorf("ActivityIDs() = %v, want [21680374805]", ids)
+	}
+}
+
+func TestUploadResultPending(t *testing.T) {
+	rawJSON := `{"detailedImportResult": {"uploadId": 1, "creationDate": "2026-01-27 08:15:42.0 GMT", "successes": [], "failures": []}}`
+
+	var result UploadResult
+	if err := json.Unmarshal([]byte(rawJSON), &result); err != nil {
+		t.Fatal(err)
+	}
+
+	if !result.Pending() {
+		t.Error("Pending() = false, want true")
+	}
+	if len(result.ActivityIDs()) != 0 {
+		t.Errorf("ActivityIDs() = %v, want empty", result.ActivityIDs())
+	}
+}
+
+func TestUploadResultRawJSON(t *testing.T) {
+	rawJSON := `{"detailedImportResult":{"uploadId":1}}`
+
+	var result UploadResult
+	if err := json.Unmarshal([]byte(rawJSON), &result); err != nil {
+		t.Fatal(err)
+	}
+	result.SetRaw(json.RawMessage(rawJSON))
+
+	if string(result.RawJSON()) != rawJSON {
+		t.Error("RawJSON should return original JSON")
+	}
+}
+
+func TestUploadCreationMillis(t *testing.T) {
+	tests := []struct {
+		date string
+		want int64
+	}{
+		{"2026-01-27 08:15:42.0 GMT", 1769501742000},
+		{"2026-01-27 08:15:42.123 GMT", 1769501742123},
+		{"2026-01-27 08:15:42 GMT", 1769501742000},
+	}
+
+	for _, tt := range tests {
+		millis, err := uploadCreationMillis(tt.date)
+		if err != nil {
+			t.Errorf("uploadCreationMillis(%q) failed: %v", tt.date, err)
+			continue
+		}
+		if millis != tt.want {
+			t.Errorf("uploadCreationMillis(%q) = %d, want %d", tt.date, millis, tt.want)
+		}
+	}
+
+	if _, err := uploadCreationMillis("not a date"); err == nil {
+		t.Error("expected error for invalid creation date")
+	}
+}
+
+func TestIsDuplicateUpload(t *testing.T) {
+	apiErr := &APIError{StatusCode: 409, Status: "409 Conflict"}
+	err := fmt.Errorf("%w: %w", ErrDuplicateUpload, apiErr)
+
+	if !IsDuplicateUpload(err) {
+		t.Error("expected IsDuplicateUpload to return true")
+	}
+	if IsDuplicateUpload(apiErr) {
+		t.Error("expected IsDuplicateUpload to return false for plain APIError")
+	}
+}
+
+func TestUploadActivityDuplicate(t *testing.T) {
+	client := newFakeClient(t, func(*http.Request) (int, []byte) {
+		return http.StatusConflict, []byte(`{"detailedImportResult": {"uploadId": 1, "failures": [{"messages": [{"code": 202, "content": "Duplicate Activity."}]}]}}`)
+	})
+
+	_, err := client.Upload.UploadActivity(context.Background(), "morning_run.fit", strings.NewReader("fit data"))
+	if !errors.Is(err, ErrDuplicateUpload) {
+		t.Fatalf("UploadActivity error = %v, want ErrDuplicateUpload", err)
+	}
+}
+
+func TestUploadActivityPollsUntilDone(t *testing.T) {
+	defer func(interval time.Duration) { uploadPollInterval = interval }(uploadPollInterval)
+	uploadPollInterval = time.Millisecond
+
+	const pending = `{"detailedImportResult": {"uploadId": 1, "uploadUuid": {"uuid": "abc"}, "creationDate": "2026-01-27 08:15:42.123 GMT", "successes": [], "failures": []}}`
+	const done = `{"detailedImportResult": {"uploadId": 1, "uploadUuid": {"uuid": "abc"}, "creationDate": "2026-01-27 08:15:42.123 GMT", "successes": [{"internalId": 42}], "failures": []}}`
+
+	var statusChecks int
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		switch req.URL.Path {
+		case "/upload-service/upload":
+			return http.StatusAccepted, []byte(pending)
+		case "/activity-service/activity/status/1769501742123/abc":
+			statusChecks++
+			if statusChecks < 2 {
+				return http.StatusAccepted, nil
+			}
+			return http.StatusCreated, []byte(done)
+		default:
+			t.Errorf("unexpected request to %s", req.URL.Path)
+			return http.StatusNotFound, nil
+		}
+	})
+
+	result, err := client.Upload.UploadActivity(context.Background(), "morning_run.fit", strings.NewReader("fit data"))
+	if err != nil {
+		t.Fatalf("UploadActivity failed: %v", err)
+	}
+	if statusChecks != 2 {
+		t.Errorf("status checks = %d, want 2", statusChecks)
+	}
+	if result.Pending() {
+		t.Error("Pending() = true, want false")
+	}
+	if ids := result.ActivityIDs(); len(ids) != 1 || ids[0] != 42 {
+		t.Errorf("ActivityIDs() = %v, want [42]", ids)
+	}
+}
@@ -4,6 +4,10 @@ package garmin
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
 )
 
 // SocialProfile represents the user's social profile information.
@@ -232,6 +236,115 @@ func (p *ProfileSettings) SetRaw(data json.RawMessage) {
 	p.raw = data
 }
 
+// Measurement systems of the user settings.
+const (
+	MeasurementSystemMetric    = "metric"
+	MeasurementSystemStatuteUS = "statute_us"
+	MeasurementSystemStatuteUK = "statute_uk"
+)
+
+// Time formats of the user settings.
+const (
+	TimeFormat12h = "time_twelve_hr"
+	TimeFormat24h = "time_twenty_four_hr"
+)
+
+// hydrationUnits are the accepted hydration measurement units.
+var hydrationUnits = []string{"ml", "oz", "cup"}
+
+// secondsPerDay bounds the sleep and wake times of the user settings.
+const secondsPerDay = 24 * 60 * 60
+
+// UserSettingsUpdate describes changes to the user settings. Only non-nil fields are updated.
+type UserSettingsUpdate struct {
+	MeasurementSystem        *string // MeasurementSystemMetric, MeasurementSystemStatuteUS or MeasurementSystemStatuteUK
+	TimeFormat               *string // TimeFormat12h or TimeFormat24h
+	HeartRateFormat          *string // format key, e.g. "bpm"
+	PowerFormat              *string // format key, e.g. "watt"
+	HydrationMeasurementUnit *string // "ml", "oz" or "cup"
+	SleepTime                *int    // usual bedtime, seconds after midnight
+	WakeTime                 *int    // usual wake time, seconds after midnight
+}
+
+// IsEmpty returns true if the update does not change any setting.
+func (u *UserSettingsUpdate) IsEmpty() bool {
+	return u.MeasurementSystem == nil && u.TimeFormat == nil && u.HeartRateFormat == nil &&
+		u.PowerFormat == nil && u.HydrationMeasurementUnit == nil && u.SleepTime == nil && u.WakeTime == nil
+}
+
+// Validate checks that the settings to change have accepted values.
+func (u *UserSettingsUpdate) Validate() error {
+	if u.IsEmpty() {
+		return errors.New("no user settings to update")
+	}
+	choices := []struct {
+		name  string
+		value *string
+		valid []string
+	}{
+		{"measurement system", u.MeasurementSystem, []string{MeasurementSystemMetric, MeasurementSystemStatuteUS, MeasurementSystemStatuteUK}},
+		{"time format", u.TimeFormat, []string{TimeFormat12h, TimeFormat24h}},
+		{"hydration unit", u.HydrationMeasurementUnit, hydrationUnits},
+	}
+	for _, c := range choices {
+		if c.value != nil && !slices.Contains(c.valid, *c.value) {
+			return fmt.Errorf("invalid %s %q (valid: %v)", c.name, *c.value, c.valid)
+		}
+	}
+	if u.HeartRateFormat != nil && *u.HeartRateFormat == "" {
+		return errors.New("heart rate format cannot be empty")
+	}
+	if u.PowerFormat != nil && *u.PowerFormat == "" {
+		return errors.New("power format cannot be empty")
+	}
+	times := []struct {
+		name  string
+		value *int
+	}{{"sleep time", u.SleepTime}, {"wake time", u.WakeTime}}
+	for _, t := range times {
+		if t.value != nil && (*t.value < 0 || *t.value >= secondsPerDay) {
+			return fmt.Errorf("%s must be between 0 and %d seconds after midnight, got %d", t.name, secondsPerDay-1, *t.value)
+		}
+	}
+	if u.SleepTime != nil && u.WakeTime != nil && *u.SleepTime == *u.WakeTime {
+		return errors.New("sleep time and wake time cannot be the same")
+	}
+	return nil
+}
+
+// formatKeyRef references a display format by its key.
+type formatKeyRef struct {
+	FormatKey string `json:"formatKey"`
+}
+
+// userDataUpdate holds the fields of UserData to change.
+type userDataUpdate struct {
+	MeasurementSystem              *string       `json:"measurementSystem,omitempty"`
+	TimeFormat                     *string       `json:"timeFormat,omitempty"`
+	HeartRateFormat                *formatKeyRef `json:"heartRateFormat,omitempty"`
+	PowerFormat                    *formatKeyRef `json:"powerFormat,omitempty"`
+	HydrationMeasurementUnit       *string       `json:"hydrationMeasurementUnit,omitempty"`
+	LactateThresholdHeartRate      *int          `json:"lactateThresholdHeartRate,omitempty"`
+	LactateThresholdSpeed          *float64      `json:"lactateThresholdSpeed,omitempty"`
+	ThresholdHeartRateAutoDetected *bool         `json:"thresholdHeartRateAutoDetected,omitempty"`
+	FunctionalThresholdPower       *int          `json:"functionalThresholdPower,omitempty"`
+	FTPAutoDetected                *bool         `json:"ftpAutoDetected,omitempty"`
+}
+
+// userSleepUpdate holds the fields of UserSleep to change.
+type userSleepUpdate struct {
+	SleepTime        *int  `json:"sleepTime,omitempty"`
+	DefaultSleepTime *bool `json:"defaultSleepTime,omitempty"`
+	WakeTime         *int  `json:"wakeTime,omitempty"`
+	DefaultWakeTime  *bool `json:"defaultWakeTime,omitempty"`
+}
+
+// userSettingsRequest is the request body for updating the user settings.
+type userSettingsRequest struct {
+	UserData  *userDataUpdate  `json:"userData,omitempty"`
+	UserSleep *userSleepUpdate `json:"userSleep,omitempty"`
+}
+
 // GetSocialProfile retrieves the user's social profile.
 func (s *UserProfileService) GetSocialProfile(ctx context.Context) (*SocialProfile, error) {
 	return fetch[SocialProfile](ctx, s.client, "/userprofile-service/socialProfile")
@@ -246,3 +359,56 @@ func (s *UserProfileService) GetUserSettings(ctx context.Context) (*UserSettings
 func (s *UserProfileService) GetProfileSettings(ctx context.Context) (*ProfileSettings, error) {
 	return fetch[ProfileSettings](ctx, s.client, "/userprofile-service/userprofile/settings")
 }
+
+// UpdateUserSettings updates the user settings that are set in update.
+func (s *UserProfileService) UpdateUserSettings(ctx context.Context, update *UserSettingsUpdate) error {
+	if err := update.Validate(); err != nil {
+		return err
+	}
+
+	req := &userSettingsRequest{}
+	data := &userDataUpdate{
+		MeasurementSystem:        update.MeasurementSystem,
+		TimeFormat:               update.TimeFormat,
+		HydrationMeasurementUnit: update.HydrationMeasurementUnit,
+	}
+	if update.HeartRateFormat != nil {
+		data.HeartRateFormat = &formatKeyRef{FormatKey: *update.HeartRateFormat}
+	}
+	if update.PowerFormat != nil {
+		data.PowerFormat = &formatKeyRef{FormatKey: *update.PowerFormat}
+	}
+	if *data != (userDataUpdate{}) {
+		req.UserData = data
+	}
+	if update.SleepTime != nil || update.WakeTime != nil {
+		notDefault := false
+		req.UserSleep = &userSleepUpdate{SleepTime: update.SleepTime, WakeTime: update.WakeTime}
+		if update.SleepTime != nil {
+			req.UserSleep.DefaultSleepTime = &notDefault
+		}
+		if update.WakeTime != nil {
+			req.UserSleep.DefaultWakeTime = &notDefault
+		}
+	}
+	return s.updateUserSettings(ctx, req)
+}
+
+// updateUserSettings sends a partial user settings update.
+func (s *UserProfileService) updateUserSettings(ctx context.Context, req *userSettingsRequest) error {
+	_, err := send[ignoredResponse](ctx, s.client, http.MethodPut, "/userprofile-service/userprofile/user-settings", req)
+	return err
+}
+
+// resolveDisplayName returns displayName, or the current user's display name
+// from the social profile if it is empty.
+func (s *UserProfileService) resolveDisplayName(ctx context.Context, displayName string) (string, error) {
+	if displayName != "" {
+		return displayName, nil
+	}
+	profile, err := s.GetSocialProfile(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get display name: %w", err)
+	}
+	return profile.DisplayName, nil
+}
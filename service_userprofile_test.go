@@ -2,7 +2,9 @@
 package garmin
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 )
 
@@ -324,3 +326,81 @@ func TestSocialProfileRawJSON(t *testing.T) {
 		t.Error("RawJSON should return original JSON")
 	}
 }
+
+func TestUserSettingsUpdateValidate(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	seconds := func(v int) *int { return &v }
+	tests := []struct {
+		name    string
+		update  UserSettingsUpdate
+		wantErr bool
+	}{
+		{"empty", UserSettingsUpdate{}, true},
+		{"metric", UserSettingsUpdate{MeasurementSystem: ptr(MeasurementSystemMetric)}, false},
+		{"unknown measurement system", UserSettingsUpdate{MeasurementSystem: ptr("imperial")}, true},
+		{"unknown time format", UserSettingsUpdate{TimeFormat: ptr("24h")}, true},
+		{"unknown hydration unit", UserSettingsUpdate{HydrationMeasurementUnit: ptr("liter")}, true},
+		{"empty heart rate format", UserSettingsUpdate{HeartRateFormat: ptr("")}, true},
+		{"sleep window", UserSettingsUpdate{SleepTime: seconds(82800), WakeTime: seconds(25200)}, false},
+		{"sleep time after midnight", UserSettingsUpdate{SleepTime: seconds(86400)}, true},
+		{"same sleep and wake time", UserSettingsUpdate{SleepTime: seconds(3600), WakeTime: seconds(3600)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.update.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateUserSettings(t *testing.T) {
+	var sent map[string]any
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.Method != http.MethodPut || req.URL.Path != "/userprofile-service/userprofile/user-settings" {
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		return http.StatusNoContent, nil
+	})
+
+	system, format, sleep := MeasurementSystemStatuteUK, "percent_max", 81000
+	err := client.UserProfile.UpdateUserSettings(context.Background(), &UserSettingsUpdate{
+		MeasurementSystem: &system,
+		HeartRateFormat:   &format,
+		SleepTime:         &sleep,
+	})
+	if err != nil {
+		t.Fatalf("UpdateUserSettings failed: %v", err)
+	}
+
+	data, _ := sent["userData"].(map[string]any)
+	if len(data) != 2 || data["measurementSystem"] != system {
+		t.Errorf("userData = %v, want only measurementSystem and heartRateFormat", data)
+	}
+	if hr, _ := data["heartRateFormat"].(map[string]any); hr["formatKey"] != format {
+		t.Errorf("heartRateFormat = %v, want formatKey %s", data["heartRateFormat"], format)
+	}
+	userSleep, _ := sent["userSleep"].(map[string]any)
+	if userSleep["sleepTime"] != float64(sleep) || userSleep["defaultSleepTime"] != false {
+		t.Errorf("userSleep = %v, want sleepTime %d", userSleep, sleep)
+	}
+	if _, ok := userSleep["wakeTime"]; ok {
+		t.Errorf("userSleep = %v, want wake time left unchanged", userSleep)
+	}
+}
+
+func TestUpdateUserSettingsInvalid(t *testing.T) {
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		return http.StatusNoContent, nil
+	})
+
+	format := "24h"
+	if err := client.UserProfile.UpdateUserSettings(context.Background(), &UserSettingsUpdate{TimeFormat: &format}); err == nil {
+		t.Error("expected error for an unknown time format")
+	}
+}
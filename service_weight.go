@@ -2,12 +2,27 @@
 package garmin
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 )
 
+// kgPerLb is the number of kilograms in a pound.
+const kgPerLb = 0.45359237
+
+// weighInTimeFormat is the timestamp format of the weigh-in endpoint.
+const weighInTimeFormat = "2006-01-02T15:04:05.00"
+
+// LbsToKg converts a weight in pounds to kilograms.
+func LbsToKg(lbs float64) float64 {
+	return lbs * kgPerLb
+}
+
 // WeightEntry represents a single weight measurement.
 type WeightEntry struct {
 	SamplePK       *int64   `json:"samplePk"`
@@ -111,3 +126,124 @@ func (s *WeightService) GetRange(ctx context.Context, startDate, endDate time.Ti
 		startDate.Format("2006-01-02"),
 		endDate.Format("2006-01-02")))
 }
+
+// BodyComposition represents the body composition measurements of a date range.
+type BodyComposition struct {
+	StartDate      string        `json:"startDate"`
+	EndDate        string        `json:"endDate"`
+	DateWeightList []WeightEntry `json:"dateWeightList"`
+	TotalAverage   WeightAverage `json:"totalAverage"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (b *BodyComposition) RawJSON() json.RawMessage { return b.raw }
+
+// SetRaw sets the raw JSON response.
+func (b *BodyComposition) SetRaw(data json.RawMessage) { b.raw = data }
+
+// WeighIn is a weight measurement to add, with optional body composition.
+type WeighIn struct {
+	Timestamp  time.Time // time of the measurement; defaults to now
+	WeightKg   float64
+	BodyFat    *float64 // percent
+	BodyWater  *float64 // percent
+	MuscleMass *float64 // kg
+	BoneMass   *float64 // kg
+	BMI        *float64
+}
+
+// Validate checks that the weigh-in values are plausible.
+func (w *WeighIn) Validate() error {
+	if w.WeightKg <= 0 || w.WeightKg > 500 {
+		return fmt.Errorf("weight must be between 0 and 500 kg, got %.1f", w.WeightKg)
+	}
+	percentages := []struct {
+		name  string
+		value *float64
+	}{{"body fat", w.BodyFat}, {"body water", w.BodyWater}}
+	for _, p := range percentages {
+		if p.value != nil && (*p.value < 0 || *p.value > 100) {
+			return fmt.Errorf("%s must be a percentage between 0 and 100, got %.1f", p.name, *p.value)
+		}
+	}
+	masses := []struct {
+		name  string
+		value *float64
+	}{{"muscle mass", w.MuscleMass}, {"bone mass", w.BoneMass}}
+	for _, m := range masses {
+		if m.value != nil && (*m.value < 0 || *m.value > w.WeightKg) {
+			return fmt.Errorf("%s must be between 0 and the weight, got %.1f kg", m.name, *m.value)
+		}
+	}
+	if w.BMI != nil && (*w.BMI <= 0 || *w.BMI > 100) {
+		return fmt.Errorf("BMI must be between 0 and 100, got %.1f", *w.BMI)
+	}
+	return nil
+}
+
+// weighInRequest is the request body for adding a weigh-in.
+type weighInRequest struct {
+	DateTimestamp string  `json:"dateTimestamp"`
+	GMTTimestamp  string  `json:"gmtTimestamp"`
+	UnitKey       string  `json:"unitKey"`
+	SourceType    string  `json:"sourceType"`
+	Value         float64 `json:"value"`
+}
+
+// hasComposition reports whether any body composition value is set.
+func (w *WeighIn) hasComposition() bool {
+	return w.BodyFat != nil || w.BodyWater != nil || w.MuscleMass != nil || w.BoneMass != nil || w.BMI != nil
+}
+
+// AddWeighIn adds a manual weigh-in.
+// The weight-service only stores the weight, so a weigh-in with body composition
+// is uploaded as a FIT weight scale file instead, like a smart scale would.
+// Returns an error wrapping ErrDuplicateUpload if that file was already uploaded.
+func (s *WeightService) AddWeighIn(ctx context.Context, weighIn *WeighIn) error {
+	if err := weighIn.Validate(); err != nil {
+		return err
+	}
+	timestamp := weighIn.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	if weighIn.hasComposition() {
+		content := bytes.NewReader(encodeWeighInFIT(weighIn, timestamp))
+		_, err := upload[ignoredResponse](ctx, s.client, "/upload-service/upload", "file", "weight.fit", content)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+			return fmt.Errorf("%w: %w", ErrDuplicateUpload, err)
+		}
+		return err
+	}
+
+	req := &weighInRequest{
+		DateTimestamp: timestamp.Format(weighInTimeFormat),
+		GMTTimestamp:  timestamp.UTC().Format(weighInTimeFormat),
+		UnitKey:       "kg",
+		SourceType:    "MANUAL",
+		Value:         weighIn.WeightKg,
+	}
+	_, err := send[ignoredResponse](ctx, s.client, http.MethodPost, "/weight-service/user-weight", req)
+	return err
+}
+
+// Delete deletes a weigh-in. The weightPK is the SamplePK of the WeightEntry.
+func (s *WeightService) Delete(ctx context.Context, date time.Time, weightPK int64) error {
+	if weightPK <= 0 {
+		return errors.New("invalid weigh-in ID")
+	}
+	path := fmt.Sprintf("/weight-service/weight/%s/byversion/%d", date.Format("2006-01-02"), weightPK)
+	return sendEmpty(ctx, s.client, http.MethodDelete, path)
+}
+
+// GetBodyComposition retrieves a snapshot of body composition measurements for a date range.
+func (s *WeightService) GetBodyComposition(ctx context.Context, startDate, endDate time.Time) (*BodyComposition, error) {
+	params := url.Values{}
+	params.Set("startDate", startDate.Format("2006-01-02"))
+	params.Set("endDate", endDate.Format("2006-01-02"))
+	return fetch[BodyComposition](ctx, s.client, "/weight-service/weight/daterangesnapshot?"+params.Encode())
+}
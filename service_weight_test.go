@@ -2,8 +2,14 @@
 package garmin
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
 	"testing"
+	"time"
 )
 
 const testDateWeight = "2026-01-27"
@@ -178,3 +184,138 @@ func TestWeightRangeRawJSON(t *testing.T) {
 		t.Error("RawJSON should return original JSON")
 	}
 }
+
+func TestLbsToKg(t *testing.T) {
+	if got := LbsToKg(100); math.Abs(got-45.359237) > 1e-9 {
+		t.Errorf("LbsToKg(100) = %v, want 45.359237", got)
+	}
+}
+
+func TestWeighInValidate(t *testing.T) {
+	pct := func(v float64) *float64 { return &v }
+
+	valid := WeighIn{WeightKg: 72.5, BodyFat: pct(18), BodyWater: pct(55), MuscleMass: pct(33), BoneMass: pct(3.2), BMI: pct(22.4)}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+
+	tests := []struct {
+		name    string
+		weighIn WeighIn
+	}{
+		{"zero weight", WeighIn{}},
+		{"too heavy", WeighIn{WeightKg: 600}},
+		{"body fat over 100", WeighIn{WeightKg: 70, BodyFat: pct(120)}},
+		{"negative body water", WeighIn{WeightKg: 70, BodyWater: pct(-1)}},
+		{"muscle heavier than body", WeighIn{WeightKg: 70, MuscleMass: pct(80)}},
+		{"zero BMI", WeighIn{WeightKg: 70, BMI: pct(0)}},
+	}
+	for _, tt := range tests {
+		if err := tt.weighIn.Validate(); err == nil {
+			t.Errorf("%s: expected validation error", tt.name)
+		}
+	}
+}
+
+func TestWeightAddWeighIn(t *testing.T) {
+	var body map[string]any
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.Method != http.MethodPost || req.URL.Path != "/weight-service/user-weight" {
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+		return http.StatusNoContent, nil
+	})
+
+	err := client.Weight.AddWeighIn(context.Background(), &WeighIn{
+		Timestamp: time.Date(2026, 1, 27, 7, 30, 0, 0, time.FixedZone("CET", 3600)),
+		WeightKg:  72.5,
+	})
+	if err != nil {
+		t.Fatalf("AddWeighIn failed: %v", err)
+	}
+
+	want := map[string]any{
+		"dateTimestamp": "2026-01-27T07:30:00.00",
+		"gmtTimestamp":  "2026-01-27T06:30:00.00",
+		"unitKey":       "kg",
+		"sourceType":    "MANUAL",
+		"value":         72.5,
+	}
+	for key, value := range want {
+		if body[key] != value {
+			t.Errorf("body[%q] = %v, want %v", key, body[key], value)
+		}
+	}
+}
+
+func TestWeightAddWeighInWithComposition(t *testing.T) {
+	var fit []byte
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.Method != http.MethodPost || req.URL.Path != "/upload-service/upload" {
+			t.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+			return http.StatusBadRequest, nil
+		}
+		file, header, err := req.FormFile("file")
+		if err != nil {
+			t.Errorf("read upload: %v", err)
+			return http.StatusBadRequest, nil
+		}
+		defer file.Close()
+		if header.Filename != "weight.fit" {
+			t.Errorf("file name = %q, want weight.fit", header.Filename)
+		}
+		fit, _ = io.ReadAll(file)
+		return http.StatusAccepted, []byte(`{"detailedImportResult":{}}`)
+	})
+
+	bodyFat, boneMass := 18.5, 3.2
+	timestamp := time.Date(2026, 1, 27, 7, 30, 0, 0, time.FixedZone("CET", 3600))
+	err := client.Weight.AddWeighIn(context.Background(), &WeighIn{
+		Timestamp: timestamp,
+		WeightKg:  72.5,
+		BodyFat:   &bodyFat,
+		BoneMass:  &boneMass,
+	})
+	if err != nil {
+		t.Fatalf("AddWeighIn failed: %v", err)
+	}
+
+	weight := decodeFIT(t, fit)[fitMesgWeightScale]
+	want := map[byte]uint64{253: uint64(timestamp.Unix() - fitEpoch), 0: 7250, 1: 1850, 4: 320}
+	for num, value := range want {
+		if weight[num] != value {
+			t.Errorf("weight_scale field %d = %d, want %d", num, weight[num], value)
+		}
+	}
+}
+
+func TestWeightAddWeighInDuplicate(t *testing.T) {
+	client := newFakeClient(t, func(*http.Request) (int, []byte) {
+		return http.StatusConflict, []byte(`{}`)
+	})
+
+	bodyFat := 18.5
+	err := client.Weight.AddWeighIn(context.Background(), &WeighIn{WeightKg: 72.5, BodyFat: &bodyFat})
+	if !errors.Is(err, ErrDuplicateUpload) {
+		t.Errorf("AddWeighIn error = %v, want ErrDuplicateUpload", err)
+	}
+}
+
+func TestWeightDelete(t *testing.T) {
+	var method, path string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		method, path = req.Method, req.URL.Path
+		return http.StatusNoContent, nil
+	})
+
+	date := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+	if err := client.Weight.Delete(context.Background(), date, 1769500000000); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if method != http.MethodDelete || path != "/weight-service/weight/2026-01-27/byversion/1769500000000" {
+		t.Errorf("request = %s %s, want DELETE /weight-service/weight/2026-01-27/byversion/1769500000000", method, path)
+	}
+}
@@ -4,6 +4,9 @@ package garmin
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -222,6 +225,12 @@ func (s *WellnessService) GetDailyHeartRate(ctx context.Context, date time.Time)
 	return fetch[DailyHeartRate](ctx, s.client, "/wellness-service/wellness/dailyHeartRate/?date="+date.Format("2006-01-02"))
 }
 
+// GetHeartRateForDate retrieves heart rate data for the specified date from the
+// mobile gateway. It has the same content as GetDailyHeartRate.
+func (s *WellnessService) GetHeartRateForDate(ctx context.Context, date time.Time) (*DailyHeartRate, error) {
+	return fetch[DailyHeartRate](ctx, s.client, "/mobile-gateway/heartRate/forDate/"+date.Format("2006-01-02"))
+}
+
 // GetDailySpO2 retrieves blood oxygen (SpO2) data for the specified date.
 func (s *WellnessService) GetDailySpO2(ctx context.Context, date time.Time) (*DailySpO2, error) {
 	return fetch[DailySpO2](ctx, s.client, "/wellness-service/wellness/daily/spo2/"+date.Format("2006-01-02"))
@@ -236,3 +245,555 @@ func (s *WellnessService) GetDailyRespiration(ctx context.Context, date time.Tim
 func (s *WellnessService) GetDailyIntensityMinutes(ctx context.Context, date time.Time) (*DailyIntensityMinutes, error) {
 	return fetch[DailyIntensityMinutes](ctx, s.client, "/wellness-service/wellness/daily/im/"+date.Format("2006-01-02"))
 }
+
+// DailySummary represents the daily user summary: the overview of a day shown on
+// the Garmin Connect home screen.
+type DailySummary struct {
+	CalendarDate string `json:"calendarDate"`
+
+	// Steps and distance
+	TotalSteps          *int     `json:"totalSteps"`
+	DailyStepGoal       *int     `json:"dailyStepGoal"`
+	TotalDistanceMeters *float64 `json:"totalDistanceMeters"`
+
+	// Calories
+	TotalKilocalories     *float64 `json:"totalKilocalories"`
+	ActiveKilocalories    *float64 `json:"activeKilocalories"`
+	BMRKilocalories       *float64 `json:"bmrKilocalories"`
+	ConsumedKilocalories  *float64 `json:"consumedKilocalories"`
+	RemainingKilocalories *float64 `json:"remainingKilocalories"`
+
+	// Floors
+	FloorsAscended         *float64 `json:"floorsAscended"`
+	FloorsDescended        *float64 `json:"floorsDescended"`
+	UserFloorsAscendedGoal *int     `json:"userFloorsAscendedGoal"`
+
+	// Heart rate
+	RestingHeartRate                 *int `json:"restingHeartRate"`
+	LastSevenDaysAvgRestingHeartRate *int `json:"lastSevenDaysAvgRestingHeartRate"`
+	MinHeartRate                     *int `json:"minHeartRate"`
+	MaxHeartRate                     *int `json:"maxHeartRate"`
+
+	// Stress (levels 0-100, durations in seconds)
+	AverageStressLevel   *int    `json:"averageStressLevel"`
+	MaxStressLevel       *int    `json:"maxStressLevel"`
+	StressQualifier      *string `json:"stressQualifier"` // e.g. "CALM", "BALANCED", "STRESSFUL"
+	RestStressDuration   *int    `json:"restStressDuration"`
+	LowStressDuration    *int    `json:"lowStressDuration"`
+	MediumStressDuration *int    `json:"mediumStressDuration"`
+	HighStressDuration   *int    `json:"highStressDuration"`
+
+	// Body battery
+	BodyBatteryHighestValue    *int `json:"bodyBatteryHighestValue"`
+	BodyBatteryLowestValue     *int `json:"bodyBatteryLowestValue"`
+	BodyBatteryMostRecentValue *int `json:"bodyBatteryMostRecentValue"`
+	BodyBatteryChargedValue    *int `json:"bodyBatteryChargedValue"`
+	BodyBatteryDrainedValue    *int `json:"bodyBatteryDrainedValue"`
+	BodyBatteryAtWakeTime      *int `json:"bodyBatteryAtWakeTime"`
+
+	// Intensity minutes
+	ModerateIntensityMinutes *int `json:"moderateIntensityMinutes"`
+	VigorousIntensityMinutes *int `json:"vigorousIntensityMinutes"`
+	IntensityMinutesGoal     *int `json:"intensityMinutesGoal"`
+
+	// Time spent per activity level, in seconds
+	HighlyActiveSeconds *int `json:"highlyActiveSeconds"`
+	ActiveSeconds       *int `json:"activeSeconds"`
+	SedentarySeconds    *int `json:"sedentarySeconds"`
+	SleepingSeconds     *int `json:"sleepingSeconds"`
+
+	// Blood oxygen and respiration
+	AverageSpO2              *float64 `json:"averageSpo2"`
+	LowestSpO2               *float64 `json:"lowestSpo2"`
+	AvgWakingRespirationRate *float64 `json:"avgWakingRespirationValue"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (d *DailySummary) RawJSON() json.RawMessage { return d.raw }
+
+// SetRaw sets the raw JSON response.
+func (d *DailySummary) SetRaw(data json.RawMessage) { d.raw = data }
+
+// IntensityMinutes returns the intensity minutes of the day as counted towards the
+// weekly goal (vigorous minutes count double).
+func (d *DailySummary) IntensityMinutes() int {
+	var total int
+	if d.ModerateIntensityMinutes != nil {
+		total += *d.ModerateIntensityMinutes
+	}
+	if d.VigorousIntensityMinutes != nil {
+		total += 2 * *d.VigorousIntensityMinutes
+	}
+	return total
+}
+
+// GetDailySummary retrieves the daily user summary for the specified date.
+// The current user's display name is fetched from the social profile.
+func (s *WellnessService) GetDailySummary(ctx context.Context, date time.Time) (*DailySummary, error) {
+	displayName, err := s.client.UserProfile.resolveDisplayName(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/usersummary-service/usersummary/daily/%s?calendarDate=%s",
+		url.PathEscape(displayName), date.Format("2006-01-02"))
+	return fetch[DailySummary](ctx, s.client, path)
+}
+
+// Limits of the usersummary stats endpoints per request. Longer ranges are split.
+const (
+	wellnessStatsMaxDays  = 28
+	wellnessStatsMaxWeeks = 52
+)
+
+// Keys of the values of stress stats.
+const (
+	StressStatOverallLevel   = "overallStressLevel"
+	StressStatRestDuration   = "restStressDuration"   // seconds
+	StressStatLowDuration    = "lowStressDuration"    // seconds
+	StressStatMediumDuration = "mediumStressDuration" // seconds
+	StressStatHighDuration   = "highStressDuration"   // seconds
+)
+
+// Keys of the values of intensity minutes stats.
+const (
+	IntensityStatModerate = "moderateValue"
+	IntensityStatVigorous = "vigorousValue"
+)
+
+// StatsPoint is a point of a stats time series: the values of a day, or of the
+// week starting at CalendarDate.
+// Values that are null have a nil entry.
+type StatsPoint struct {
+	CalendarDate string              `json:"calendarDate"`
+	Values       map[string]*float64 `json:"values"`
+}
+
+// UnmarshalJSON decodes the point. Values that are not numbers are left out
+// rather than failing the whole series.
+func (p *StatsPoint) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		CalendarDate string                     `json:"calendarDate"`
+		Values       map[string]json.RawMessage `json:"values"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	p.CalendarDate = aux.CalendarDate
+	p.Values = make(map[string]*float64, len(aux.Values))
+	for key, raw := range aux.Values {
+		var v *float64
+		if err := json.Unmarshal(raw, &v); err != nil {
+			continue
+		}
+		p.Values[key] = v
+	}
+	return nil
+}
+
+// Date returns the calendar date of the point.
+func (p *StatsPoint) Date() time.Time {
+	d, _ := time.Parse("2006-01-02", p.CalendarDate)
+	return d
+}
+
+// Value returns the value for key, or false if the point has no such value or it is null.
+func (p *StatsPoint) Value(key string) (float64, bool) {
+	v := p.Values[key]
+	if v == nil {
+		return 0, false
+	}
+	return *v, true
+}
+
+// StatsSeries is a daily or weekly stats time series, oldest first.
+type StatsSeries struct {
+	Items []StatsPoint
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response. For ranges fetched in several
+// requests, this is the merged array.
+func (s *StatsSeries) RawJSON() json.RawMessage { return s.raw }
+
+// SetRaw sets the raw JSON response.
+func (s *StatsSeries) SetRaw(data json.RawMessage) { s.raw = data }
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (s *StatsSeries) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &s.Items)
+}
+
+// On returns the value for key on the given date, or false if there is none.
+func (s *StatsSeries) On(date time.Time, key string) (float64, bool) {
+	day := date.Format("2006-01-02")
+	for i := range s.Items {
+		if s.Items[i].CalendarDate == day {
+			return s.Items[i].Value(key)
+		}
+	}
+	return 0, false
+}
+
+// Average returns the mean of the non-null values for key over the points that
+// have it, or false if none has.
+func (s *StatsSeries) Average(key string) (float64, bool) {
+	var sum float64
+	var count int
+	for i := range s.Items {
+		if v, ok := s.Items[i].Value(key); ok {
+			sum += v
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return sum / float64(count), true
+}
+
+// GetDailyStressStats retrieves daily stress stats for a date range. Ranges longer
+// than 28 days are split into several requests and merged.
+func (s *WellnessService) GetDailyStressStats(ctx context.Context, start, end time.Time) (*StatsSeries, error) {
+	return s.dailyStats(ctx, "stress", start, end)
+}
+
+// GetWeeklyStressStats retrieves stress stats for the given number of weeks ending
+// at end. More than 52 weeks are split into several requests and merged.
+func (s *WellnessService) GetWeeklyStressStats(ctx context.Context, end time.Time, weeks int) (*StatsSeries, error) {
+	return fetchDateRange[StatsSeries](ctx, s.client, weeksStart(end, weeks), end, wellnessStatsMaxWeeks*7, func(start, end time.Time) string {
+		return fmt.Sprintf("/usersummary-service/stats/stress/weekly/%s/%d", end.Format("2006-01-02"), daysBetween(start, end)/7)
+	})
+}
+
+// GetDailyIntensityMinutesStats retrieves daily intensity minutes for a date range.
+// Ranges longer than 28 days are split into several requests and merged.
+func (s *WellnessService) GetDailyIntensityMinutesStats(ctx context.Context, start, end time.Time) (*StatsSeries, error) {
+	return s.dailyStats(ctx, "im", start, end)
+}
+
+// GetWeeklyIntensityMinutesStats retrieves intensity minutes for the given number of
+// weeks ending at end. More than 52 weeks are split into several requests and merged.
+func (s *WellnessService) GetWeeklyIntensityMinutesStats(ctx context.Context, end time.Time, weeks int) (*StatsSeries, error) {
+	return fetchDateRange[StatsSeries](ctx, s.client, weeksStart(end, weeks), end, wellnessStatsMaxWeeks*7, func(start, end time.Time) string {
+		return fmt.Sprintf("/usersummary-service/stats/im/weekly/%s/%s", start.Format("2006-01-02"), end.Format("2006-01-02"))
+	})
+}
+
+// dailyStats fetches a daily usersummary stats endpoint in chunks.
+func (s *WellnessService) dailyStats(ctx context.Context, metric string, start, end time.Time) (*StatsSeries, error) {
+	return fetchDateRange[StatsSeries](ctx, s.client, start, end, wellnessStatsMaxDays, func(start, end time.Time) string {
+		return fmt.Sprintf("/usersummary-service/stats/%s/daily/%s/%s", metric, start.Format("2006-01-02"), end.Format("2006-01-02"))
+	})
+}
+
+// weeksStart returns the first day of the given number of weeks ending at end.
+func weeksStart(end time.Time, weeks int) time.Time {
+	if weeks < 1 {
+		weeks = 1
+	}
+	return end.AddDate(0, 0, -7*weeks+1)
+}
+
+// daysBetween returns the number of days of the inclusive range [start, end].
+func daysBetween(start, end time.Time) int {
+	return int(end.Sub(start).Round(24*time.Hour).Hours()/24) + 1
+}
+
+// wellnessTimestampFormat is the format of the GMT timestamps of wellness time
+// series. Fractional seconds are accepted when parsing.
+const wellnessTimestampFormat = "2006-01-02T15:04:05"
+
+// seriesNumber returns the number at index i of a positional time series row,
+// or false if the row has no number there.
+func seriesNumber(row []any, i int) (float64, bool) {
+	if i < 0 || i >= len(row) {
+		return 0, false
+	}
+	v, ok := row[i].(float64)
+	return v, ok
+}
+
+// seriesTimestamp parses the GMT timestamp at index i of a positional time series
+// row, or returns false if the row has no timestamp there.
+func seriesTimestamp(row []any, i int) (time.Time, bool) {
+	if i < 0 || i >= len(row) {
+		return time.Time{}, false
+	}
+	s, ok := row[i].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(wellnessTimestampFormat, s)
+	return t, err == nil
+}
+
+// seriesIndex returns the index of key in the value descriptors, or def if the
+// response has no descriptor for it.
+func seriesIndex(indexes map[string]int, key string, def int) int {
+	if i, ok := indexes[key]; ok {
+		return i
+	}
+	return def
+}
+
+// BodyBatteryValueDescriptor describes the format of body battery values.
+type BodyBatteryValueDescriptor struct {
+	Index int    `json:"bodyBatteryValueDescriptorIndex"`
+	Key   string `json:"bodyBatteryValueDescriptorKey"`
+}
+
+// BodyBatteryReading is the body battery level at a point in time.
+type BodyBatteryReading struct {
+	Time  time.Time `json:"time"`
+	Value int       `json:"value"` // 0-100
+}
+
+// BodyBatteryReport represents the body battery of a day.
+type BodyBatteryReport struct {
+	Date                string               `json:"date"`
+	Charged             *int                 `json:"charged"`
+	Drained             *int                 `json:"drained"`
+	StartTimestampGMT   string               `json:"startTimestampGMT"`
+	EndTimestampGMT     string               `json:"endTimestampGMT"`
+	StartTimestampLocal string               `json:"startTimestampLocal"`
+	EndTimestampLocal   string               `json:"endTimestampLocal"`
+	Values              []BodyBatteryReading `json:"bodyBatteryValues"`
+}
+
+// UnmarshalJSON decodes the positional body battery values array into Values.
+func (r *BodyBatteryReport) UnmarshalJSON(data []byte) error {
+	type alias BodyBatteryReport
+	aux := struct {
+		*alias
+		Descriptors []BodyBatteryValueDescriptor `json:"bodyBatteryValueDescriptorDTOList"`
+		ValuesArray [][]any                      `json:"bodyBatteryValuesArray"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	indexes := make(map[string]int, len(aux.Descriptors))
+	for _, d := range aux.Descriptors {
+		indexes[d.Key] = d.Index
+	}
+	timeIdx := seriesIndex(indexes, "timestamp", 0)
+	levelIdx := seriesIndex(indexes, "bodyBatteryLevel", 1)
+
+	r.Values = make([]BodyBatteryReading, 0, len(aux.ValuesArray))
+	for _, row := range aux.ValuesArray {
+		ts, ok := seriesNumber(row, timeIdx)
+		if !ok {
+			continue
+		}
+		level, ok := seriesNumber(row, levelIdx)
+		if !ok {
+			continue // no reading, e.g. the watch was off
+		}
+		r.Values = append(r.Values, BodyBatteryReading{Time: time.UnixMilli(int64(ts)).UTC(), Value: int(level)})
+	}
+	return nil
+}
+
+// BodyBatteryReports represents the daily body battery reports of a date range.
+type BodyBatteryReports struct {
+	Items []BodyBatteryReport
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response. For ranges fetched in several
+// requests, this is the merged array.
+func (b *BodyBatteryReports) RawJSON() json.RawMessage { return b.raw }
+
+// SetRaw sets the raw JSON response.
+func (b *BodyBatteryReports) SetRaw(data json.RawMessage) { b.raw = data }
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (b *BodyBatteryReports) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &b.Items)
+}
+
+// FloorsValueDescriptor describes the format of floors values.
+type FloorsValueDescriptor struct {
+	Key   string `json:"key"`
+	Index int    `json:"index"`
+}
+
+// FloorsInterval is the number of floors climbed and descended during an interval.
+type FloorsInterval struct {
+	Time      time.Time `json:"time"` // start of the interval
+	End       time.Time `json:"end"`
+	Ascended  int       `json:"ascended"`
+	Descended int       `json:"descended"`
+}
+
+// DailyFloors represents the floors climbed throughout a day.
+type DailyFloors struct {
+	StartTimestampGMT   string           `json:"startTimestampGMT"`
+	EndTimestampGMT     string           `json:"endTimestampGMT"`
+	StartTimestampLocal string           `json:"startTimestampLocal"`
+	EndTimestampLocal   string           `json:"endTimestampLocal"`
+	Values              []FloorsInterval `json:"floorValues"`
+
+	raw json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (d *DailyFloors) RawJSON() json.RawMessage { return d.raw }
+
+// SetRaw sets the raw JSON response.
+func (d *DailyFloors) SetRaw(data json.RawMessage) { d.raw = data }
+
+// UnmarshalJSON decodes the positional floor values array into Values.
+func (d *DailyFloors) UnmarshalJSON(data []byte) error {
+	type alias DailyFloors
+	aux := struct {
+		*alias
+		Descriptors []FloorsValueDescriptor `json:"floorsValueDescriptorDTOList"`
+		ValuesArray [][]any                 `json:"floorValuesArray"`
+	}{alias: (*alias)(d)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	indexes := make(map[string]int, len(aux.Descriptors))
+	for _, desc := range aux.Descriptors {
+		indexes[desc.Key] = desc.Index
+	}
+	startIdx := seriesIndex(indexes, "startTimeGMT", 0)
+	endIdx := seriesIndex(indexes, "endTimeGMT", 1)
+	ascendedIdx := seriesIndex(indexes, "floorsAscended", 2)
+	descendedIdx := seriesIndex(indexes, "floorsDescended", 3)
+
+	d.Values = make([]FloorsInterval, 0, len(aux.ValuesArray))
+	for _, row := range aux.ValuesArray {
+		start, ok := seriesTimestamp(row, startIdx)
+		if !ok {
+			continue
+		}
+		end, _ := seriesTimestamp(row, endIdx)
+		ascended, _ := seriesNumber(row, ascendedIdx)
+		descended, _ := seriesNumber(row, descendedIdx)
+		d.Values = append(d.Values, FloorsInterval{Time: start, End: end, Ascended: int(ascended), Descended: int(descended)})
+	}
+	return nil
+}
+
+// Totals returns the floors climbed and descended over the day.
+func (d *DailyFloors) Totals() (ascended, descended int) {
+	for _, v := range d.Values {
+		ascended += v.Ascended
+		descended += v.Descended
+	}
+	return ascended, descended
+}
+
+// DailyEvent represents an activity detected automatically during the day (Move IQ).
+type DailyEvent struct {
+	CalendarDate        string  `json:"calendarDate"`
+	StartTimestampGMT   string  `json:"startTimestampGMT"`
+	StartTimestampLocal string  `json:"startTimestampLocal"`
+	TimezoneOffset      int64   `json:"timezoneOffset"` // milliseconds
+	ActivityType        string  `json:"activityType"`   // e.g. "walking", "running"
+	ActivitySubType     *string `json:"activitySubType"`
+	Duration            int     `json:"duration"` // seconds
+}
+
+// Start returns the start time of the event.
+func (e *DailyEvent) Start() time.Time {
+	t, _ := time.Parse(wellnessTimestampFormat, e.StartTimestampGMT)
+	return t
+}
+
+// DailyEvents represents the automatically detected activities of a day.
+type DailyEvents struct {
+	Items []DailyEvent
+	raw   json.RawMessage
+}
+
+// RawJSON returns the original JSON response.
+func (d *DailyEvents) RawJSON() json.RawMessage { return d.raw }
+
+// SetRaw sets the raw JSON response.
+func (d *DailyEvents) SetRaw(data json.RawMessage) { d.raw = data }
+
+// UnmarshalJSON unmarshals the array response into the Items field.
+func (d *DailyEvents) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &d.Items)
+}
+
+// GetBodyBatteryReports retrieves the daily body battery reports for a date range.
+// Ranges longer than 28 days are split into several requests and merged.
+func (s *WellnessService) GetBodyBatteryReports(ctx context.Context, start, end time.Time) (*BodyBatteryReports, error) {
+	return fetchDateRange[BodyBatteryReports](ctx, s.client, start, end, wellnessStatsMaxDays, func(start, end time.Time) string {
+		params := url.Values{}
+		params.Set("startDate", start.Format("2006-01-02"))
+		params.Set("endDate", end.Format("2006-01-02"))
+		return "/wellness-service/wellness/bodyBattery/reports/daily?" + params.Encode()
+	})
+}
+
+// GetDailyFloors retrieves the floors climbed throughout the specified date.
+func (s *WellnessService) GetDailyFloors(ctx context.Context, date time.Time) (*DailyFloors, error) {
+	return fetch[DailyFloors](ctx, s.client, "/wellness-service/wellness/floorsChartData/daily/"+date.Format("2006-01-02"))
+}
+
+// GetDailyEvents retrieves the automatically detected activities of the specified date.
+func (s *WellnessService) GetDailyEvents(ctx context.Context, date time.Time) (*DailyEvents, error) {
+	return fetch[DailyEvents](ctx, s.client, "/wellness-service/wellness/dailyEvents/"+date.Format("2006-01-02"))
+}
+
+// restingHeartRateMetricID is the userstats-service metric ID of the resting heart rate.
+const restingHeartRateMetricID = 60
+
+// RestingHeartRateKey is the StatsSeries value key of the resting heart rate (bpm).
+const RestingHeartRateKey = "restingHeartRate"
+
+// restingHeartRateStats is the userstats-service response for the resting heart rate metric.
+type restingHeartRateStats struct {
+	MetricsMap struct {
+		RestingHeartRate []struct {
+			CalendarDate string   `json:"calendarDate"`
+			Value        *float64 `json:"value"`
+		} `json:"WELLNESS_RESTING_HEART_RATE"`
+	} `json:"metricsMap"`
+
+	raw json.RawMessage
+}
+
+// SetRaw sets the raw JSON response.
+func (r *restingHeartRateStats) SetRaw(data json.RawMessage) { r.raw = data }
+
+// series converts the measured days to a StatsSeries keyed by RestingHeartRateKey.
+func (r *restingHeartRateStats) series() *StatsSeries {
+	series := &StatsSeries{Items: make([]StatsPoint, 0, len(r.MetricsMap.RestingHeartRate)), raw: r.raw}
+	for _, p := range r.MetricsMap.RestingHeartRate {
+		if p.Value != nil {
+			series.Items = append(series.Items, StatsPoint{
+				CalendarDate: p.CalendarDate,
+				Values:       map[string]*float64{RestingHeartRateKey: p.Value},
+			})
+		}
+	}
+	return series
+}
+
+// GetRestingHeartRateRange retrieves the daily resting heart rate between two dates
+// as a series keyed by RestingHeartRateKey. Days without a measurement are omitted.
+// The current user's display name is fetched from the social profile.
+func (s *WellnessService) GetRestingHeartRateRange(ctx context.Context, start, end time.Time) (*StatsSeries, error) {
+	displayName, err := s.client.UserProfile.resolveDisplayName(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("fromDate", start.Format("2006-01-02"))
+	params.Set("untilDate", end.Format("2006-01-02"))
+	params.Set("metricId", strconv.Itoa(restingHeartRateMetricID))
+	stats, err := fetch[restingHeartRateStats](ctx, s.client, "/userstats-service/wellness/daily/"+url.PathEscape(displayName)+"?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	return stats.series(), nil
+}
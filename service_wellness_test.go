@@ -2,8 +2,11 @@
 package garmin
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
+	"time"
 )
 
 const (
@@ -407,3 +410,315 @@ func TestDailyIntensityMinutesRawJSON(t *testing.T) {
 		t.Error("RawJSON should return original JSON")
 	}
 }
+
+func TestGetDailySummary(t *testing.T) {
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		switch req.URL.Path {
+		case "/userprofile-service/socialProfile":
+			return http.StatusOK, []byte(`{"displayName": "runner-42"}`)
+		case "/usersummary-service/usersummary/daily/runner-42":
+			if got := req.URL.Query().Get("calendarDate"); got != testDateNew {
+				t.Errorf("calendarDate = %s, want %s", got, testDateNew)
+			}
+			return http.StatusOK, []byte(`{
+				"calendarDate": "2026-01-27", "totalSteps": 10234, "dailyStepGoal": 8000,
+				"totalKilocalories": 2456.0, "activeKilocalories": 612.0, "floorsAscended": 12.0,
+				"restingHeartRate": 48, "averageStressLevel": 31, "maxStressLevel": 92, "stressQualifier": "BALANCED",
+				"bodyBatteryHighestValue": 88, "bodyBatteryLowestValue": 17,
+				"moderateIntensityMinutes": 20, "vigorousIntensityMinutes": 15
+			}`)
+		default:
+			t.Errorf("unexpected request: %s", req.URL.Path)
+			return http.StatusNotFound, nil
+		}
+	})
+
+	summary, err := client.Wellness.GetDailySummary(context.Background(), time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetDailySummary failed: %v", err)
+	}
+
+	if summary.CalendarDate != testDateNew {
+		t.Errorf("CalendarDate = %s, want %s", summary.CalendarDate, testDateNew)
+	}
+	if summary.TotalSteps == nil || *summary.TotalSteps != 10234 {
+		t.Errorf("TotalSteps = %v, want 10234", summary.TotalSteps)
+	}
+	if summary.RestingHeartRate == nil || *summary.RestingHeartRate != 48 {
+		t.Errorf("RestingHeartRate = %v, want 48", summary.RestingHeartRate)
+	}
+	if summary.BodyBatteryHighestValue == nil || *summary.BodyBatteryHighestValue != 88 ||
+		summary.BodyBatteryLowestValue == nil || *summary.BodyBatteryLowestValue != 17 {
+		t.Errorf("BodyBattery high/low = %v/%v, want 88/17", summary.BodyBatteryHighestValue, summary.BodyBatteryLowestValue)
+	}
+	if got := summary.IntensityMinutes(); got != 50 {
+		t.Errorf("IntensityMinutes() = %d, want 50", got)
+	}
+	if summary.RawJSON() == nil {
+		t.Error("expected RawJSON to be available")
+	}
+}
+
+func TestStatsSeriesJSONUnmarshal(t *testing.T) {
+	rawJSON := `[
+		{"calendarDate": "2026-01-26", "values": {"overallStressLevel": 30, "highStressDuration": 1800}},
+		{"calendarDate": "2026-01-27", "values": {"overallStressLevel": 40}},
+		{"calendarDate": "2026-01-28", "values": {}}
+	]`
+
+	var series StatsSeries
+	if err := json.Unmarshal([]byte(rawJSON), &series); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if len(series.Items) != 3 {
+		t.Fatalf("len(Items) = %d, want 3", len(series.Items))
+	}
+	if v, ok := series.Items[0].Value(StressStatHighDuration); !ok || v != 1800 {
+		t.Errorf("Value(highStressDuration) = %v, %v, want 1800", v, ok)
+	}
+	if _, ok := series.Items[2].Value(StressStatOverallLevel); ok {
+		t.Error("expected no value for a day without data")
+	}
+	if avg, ok := series.Average(StressStatOverallLevel); !ok || avg != 35 {
+		t.Errorf("Average(overallStressLevel) = %v, %v, want 35", avg, ok)
+	}
+	if _, ok := series.Average(IntensityStatModerate); ok {
+		t.Error("expected no average for a missing key")
+	}
+}
+
+func TestStatsSeriesNullAndNonNumericValues(t *testing.T) {
+	rawJSON := `[
+		{"calendarDate": "2026-01-26", "values": {"overallStressLevel": 30, "stressQualifier": "BALANCED"}},
+		{"calendarDate": "2026-01-27", "values": {"overallStressLevel": null, "highStressDuration": 600}},
+		{"calendarDate": "2026-01-28", "values": {"overallStressLevel": 40}}
+	]`
+
+	var series StatsSeries
+	if err := json.Unmarshal([]byte(rawJSON), &series); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if len(series.Items) != 3 {
+		t.Fatalf("len(Items) = %d, want 3", len(series.Items))
+	}
+	if _, ok := series.Items[0].Values["stressQualifier"]; ok {
+		t.Error("expected the non-numeric value to be left out")
+	}
+	if _, ok := series.Items[1].Value(StressStatOverallLevel); ok {
+		t.Error("expected no value for a null")
+	}
+	if v, ok := series.Items[1].Value(StressStatHighDuration); !ok || v != 600 {
+		t.Errorf("Value(highStressDuration) = %v, %v, want 600", v, ok)
+	}
+	if avg, ok := series.Average(StressStatOverallLevel); !ok || avg != 35 {
+		t.Errorf("Average(overallStressLevel) = %v, %v, want 35 (nulls skipped)", avg, ok)
+	}
+}
+
+func TestWellnessStatsChunking(t *testing.T) {
+	var paths []string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		paths = append(paths, req.URL.Path)
+		return http.StatusOK, []byte(`[{"calendarDate": "2026-01-01", "values": {"moderateValue": 10, "vigorousValue": 5}}]`)
+	})
+	ctx := context.Background()
+	end := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	series, err := client.Wellness.GetDailyStressStats(ctx, end.AddDate(0, 0, -39), end)
+	if err != nil {
+		t.Fatalf("GetDailyStressStats failed: %v", err)
+	}
+	want := []string{
+		"/usersummary-service/stats/stress/daily/2025-12-19/2026-01-15",
+		"/usersummary-service/stats/stress/daily/2026-01-16/2026-01-27",
+	}
+	assertPaths(t, paths, want)
+	if len(series.Items) != 2 {
+		t.Errorf("len(Items) = %d, want 2 merged points", len(series.Items))
+	}
+
+	paths = nil
+	if _, err := client.Wellness.GetWeeklyStressStats(ctx, end, 60); err != nil {
+		t.Fatalf("GetWeeklyStressStats failed: %v", err)
+	}
+	assertPaths(t, paths, []string{
+		"/usersummary-service/stats/stress/weekly/2025-12-02/52",
+		"/usersummary-service/stats/stress/weekly/2026-01-27/8",
+	})
+
+	paths = nil
+	if _, err := client.Wellness.GetWeeklyIntensityMinutesStats(ctx, end, 4); err != nil {
+		t.Fatalf("GetWeeklyIntensityMinutesStats failed: %v", err)
+	}
+	assertPaths(t, paths, []string{"/usersummary-service/stats/im/weekly/2025-12-31/2026-01-27"})
+
+	paths = nil
+	if _, err := client.Wellness.GetDailyIntensityMinutesStats(ctx, end, end); err != nil {
+		t.Fatalf("GetDailyIntensityMinutesStats failed: %v", err)
+	}
+	assertPaths(t, paths, []string{"/usersummary-service/stats/im/daily/2026-01-27/2026-01-27"})
+}
+
+func TestBodyBatteryReportsJSONUnmarshal(t *testing.T) {
+	data := `[{
+		"date": "2026-01-27",
+		"charged": 45,
+		"drained": 52,
+		"startTimestampGMT": "2026-01-26T23:00:00.0",
+		"endTimestampGMT": "2026-01-27T23:00:00.0",
+		"bodyBatteryValueDescriptorDTOList": [
+			{"bodyBatteryValueDescriptorIndex": 0, "bodyBatteryValueDescriptorKey": "timestamp"},
+			{"bodyBatteryValueDescriptorIndex": 1, "bodyBatteryValueDescriptorKey": "bodyBatteryLevel"}
+		],
+		"bodyBatteryValuesArray": [[1769468400000, 30], [1769470200000, null], [1769472000000, 34]]
+	}]`
+
+	var reports BodyBatteryReports
+	if err := json.Unmarshal([]byte(data), &reports); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if len(reports.Items) != 1 {
+		t.Fatalf("len(Items) = %d, want 1", len(reports.Items))
+	}
+	report := reports.Items[0]
+	if report.Charged == nil || *report.Charged != 45 {
+		t.Errorf("Charged = %v, want 45", report.Charged)
+	}
+	if len(report.Values) != 2 {
+		t.Fatalf("len(Values) = %d, want 2 readings without the null one", len(report.Values))
+	}
+	want := BodyBatteryReading{Time: time.Date(2026, 1, 26, 23, 0, 0, 0, time.UTC), Value: 30}
+	if report.Values[0] != want {
+		t.Errorf("Values[0] = %+v, want %+v", report.Values[0], want)
+	}
+}
+
+func TestDailyFloorsJSONUnmarshal(t *testing.T) {
+	data := `{
+		"startTimestampGMT": "2026-01-26T23:00:00.0",
+		"endTimestampGMT": "2026-01-27T23:00:00.0",
+		"floorsValueDescriptorDTOList": [
+			{"key": "startTimeGMT", "index": 0},
+			{"key": "endTimeGMT", "index": 1},
+			{"key": "floorsAscended", "index": 2},
+			{"key": "floorsDescended", "index": 3}
+		],
+		"floorValuesArray": [
+			["2026-01-27T07:00:00.0", "2026-01-27T07:15:00.0", 3, 1],
+			["2026-01-27T07:15:00.0", "2026-01-27T07:30:00.0", 2, 4]
+		]
+	}`
+
+	var floors DailyFloors
+	if err := json.Unmarshal([]byte(data), &floors); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if len(floors.Values) != 2 {
+		t.Fatalf("len(Values) = %d, want 2", len(floors.Values))
+	}
+	first := floors.Values[0]
+	if !first.Time.Equal(time.Date(2026, 1, 27, 7, 0, 0, 0, time.UTC)) || !first.End.Equal(time.Date(2026, 1, 27, 7, 15, 0, 0, time.UTC)) {
+		t.Errorf("Values[0] interval = %v-%v, want 07:00-07:15", first.Time, first.End)
+	}
+	if ascended, descended := floors.Totals(); ascended != 5 || descended != 5 {
+		t.Errorf("Totals() = %d, %d, want 5, 5", ascended, descended)
+	}
+}
+
+func TestDailyEventsJSONUnmarshal(t *testing.T) {
+	data := `[{
+		"calendarDate": "2026-01-27",
+		"startTimestampGMT": "2026-01-27T12:30:00.0",
+		"startTimestampLocal": "2026-01-27T13:30:00.0",
+		"timezoneOffset": 3600000,
+		"activityType": "walking",
+		"duration": 1200
+	}]`
+
+	var events DailyEvents
+	if err := json.Unmarshal([]byte(data), &events); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if len(events.Items) != 1 {
+		t.Fatalf("len(Items) = %d, want 1", len(events.Items))
+	}
+	event := events.Items[0]
+	if event.ActivityType != "walking" || event.Duration != 1200 {
+		t.Errorf("event = %s %d, want walking 1200", event.ActivityType, event.Duration)
+	}
+	if !event.Start().Equal(time.Date(2026, 1, 27, 12, 30, 0, 0, time.UTC)) {
+		t.Errorf("Start() = %v, want 2026-01-27 12:30 UTC", event.Start())
+	}
+}
+
+func TestGetRestingHeartRateRange(t *testing.T) {
+	var requested string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		if req.URL.Path == "/userprofile-service/socialProfile" {
+			return http.StatusOK, []byte(`{"displayName": "runner"}`)
+		}
+		requested = req.URL.Path + "?" + req.URL.RawQuery
+		return http.StatusOK, []byte(`{
+			"from": "2026-01-25",
+			"until": "2026-01-27",
+			"metricsMap": {"WELLNESS_RESTING_HEART_RATE": [
+				{"calendarDate": "2026-01-25", "value": 52.0},
+				{"calendarDate": "2026-01-26", "value": null},
+				{"calendarDate": "2026-01-27", "value": 48.0}
+			]}
+		}`)
+	})
+	end := time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC)
+
+	rhr, err := client.Wellness.GetRestingHeartRateRange(context.Background(), end.AddDate(0, 0, -2), end)
+	if err != nil {
+		t.Fatalf("GetRestingHeartRateRange failed: %v", err)
+	}
+
+	want := "/userstats-service/wellness/daily/runner?fromDate=2026-01-25&metricId=60&untilDate=2026-01-27"
+	if requested != want {
+		t.Errorf("requested %s, want %s", requested, want)
+	}
+	if len(rhr.Items) != 2 {
+		t.Fatalf("len(Items) = %d, want 2 measured days", len(rhr.Items))
+	}
+	if bpm, ok := rhr.On(end, RestingHeartRateKey); !ok || bpm != 48 {
+		t.Errorf("On(2026-01-27) = %v, %v, want 48, true", bpm, ok)
+	}
+	if _, ok := rhr.On(end.AddDate(0, 0, -1), RestingHeartRateKey); ok {
+		t.Error("expected no value for 2026-01-26")
+	}
+	if avg, ok := rhr.Average(RestingHeartRateKey); !ok || avg != 50 {
+		t.Errorf("Average() = %v, %v, want 50, true", avg, ok)
+	}
+}
+
+// assertPaths checks the requested paths in order.
+func assertPaths(t *testing.T, got, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("requested %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("request %d = %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+func TestGetHeartRateForDate(t *testing.T) {
+	var paths []string
+	client := newFakeClient(t, func(req *http.Request) (int, []byte) {
+		paths = append(paths, req.URL.Path)
+		return http.StatusOK, []byte(`{"calendarDate": "2026-01-27", "restingHeartRate": 52, "maxHeartRate": 161}`)
+	})
+
+	hr, err := client.Wellness.GetHeartRateForDate(context.Background(), time.Date(2026, 1, 27, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetHeartRateForDate failed: %v", err)
+	}
+	assertPaths(t, paths, []string{"/mobile-gateway/heartRate/forDate/2026-01-27"})
+	if hr.RestingHeartRate != 52 || hr.MaxHeartRate != 161 {
+		t.Errorf("heart rate = %d resting, %d max, want 52 and 161", hr.RestingHeartRate, hr.MaxHeartRate)
+	}
+}
@@ -65,3 +65,18 @@ type FitnessStatsService struct{ client *Client }
 
 // CourseService provides access to course-related API endpoints.
 type CourseService struct{ client *Client }
+
+// TrainingPlanService provides access to training plan-related API endpoints.
+type TrainingPlanService struct{ client *Client }
+
+// PeriodicHealthService provides access to women's health (menstrual cycle and pregnancy) API endpoints.
+type PeriodicHealthService struct{ client *Client }
+
+// GolfService provides access to golf scorecard API endpoints.
+type GolfService struct{ client *Client }
+
+// LifestyleService provides access to lifestyle logging API endpoints.
+type LifestyleService struct{ client *Client }
+
+// GraphQLService provides access to the GraphQL gateway used by the web app for aggregated views.
+type GraphQLService struct{ client *Client }
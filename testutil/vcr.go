@@ -2,6 +2,8 @@
 package testutil
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -36,9 +38,11 @@ var (
 	locationPattern        = regexp.MustCompile(`"location"\s*:\s*"[^"]*"`)
 
 	// Device-related patterns
-	deviceIDPattern     = regexp.MustCompile(`"deviceId"\s*:\s*\d+`)
-	unitIDPattern       = regexp.MustCompile(`"unitId"\s*:\s*\d+`)
-	serialNumberPattern = regexp.MustCompile(`"serialNumber"\s*:\s*"[^"]*"`)
+	deviceIDPattern          = regexp.MustCompile(`"deviceId"\s*:\s*\d+`)
+	userDeviceIDPattern      = regexp.MustCompile(`"userDeviceId"\s*:\s*\d+`)
+	userProfileNumberPattern = regexp.MustCompile(`"userProfileNumber"\s*:\s*\d+`)
+	unitIDPattern            = regexp.MustCompile(`"unitId"\s*:\s*\d+`)
+	serialNumberPattern      = regexp.MustCompile(`"serialNumber"\s*:\s*"[^"]*"`)
 
 	// Course-related patterns
 	courseIDPattern       = regexp.MustCompile(`"courseId"\s*:\s*\d+`)
@@ -59,10 +63,17 @@ var (
 
 	// URL path patterns (for anonymizing IDs in request URLs)
 	deviceSettingsURLPattern  = regexp.MustCompile(`/device-info/settings/\d+`)
+	solarURLPattern           = regexp.MustCompile(`/web-gateway/solar/\d+`)
 	racePredictionsURLPattern = regexp.MustCompile(`/racepredictions/(latest|daily|monthly)/[a-zA-Z0-9-]+`)
 	courseURLPattern          = regexp.MustCompile(`/course-service/course/\d+`)
 	courseGPXURLPattern       = regexp.MustCompile(`/course-service/course/gpx/\d+`)
 	courseFITURLPattern       = regexp.MustCompile(`/course-service/course/fit/\d+`)
+	gearUserURLPattern        = regexp.MustCompile(`/gear-service/gear/user/\d+`)
+	userProfilePkQueryPattern = regexp.MustCompile(`userProfilePk=\d+`)
+	personalRecordsURLPattern = regexp.MustCompile(`/personalrecord/prs/[^/?]+`)
+	dailySummaryChartPattern  = regexp.MustCompile(`/dailySummaryChart/[^/?]+`)
+	userSummaryDailyPattern   = regexp.MustCompile(`/usersummary/daily/[^/?]+`)
+	userStatsWellnessPattern  = regexp.MustCompile(`/userstats-service/wellness/daily/[^/?]+`)
 
 	// Profile image URLs
 	profileImageURLPattern = regexp.MustCompile(`"(ownerProfileImageUrl[^"]*|profileImageUrl[^"]*)"\s*:\s*"https://s3\.amazonaws\.com/garmin-connect-prod/profile_images/[^"]*"`)
@@ -72,6 +83,9 @@ var (
 	profileImgNameMediumPattern = regexp.MustCompile(`"profileImgNameMedium"\s*:\s*"[^"]*"`)
 	profileImgNameSmallPattern  = regexp.MustCompile(`"profileImgNameSmall"\s*:\s*"[^"]*"`)
 
+	// Women's health responses (anonymized by decoding, see anonymizeHealthBody)
+	periodicHealthURLPattern = regexp.MustCompile(`/periodichealth-service/`)
+
 	// Auth-related patterns
 	ticketPattern          = regexp.MustCompile(`ticket=ST-[^&"\\]+`)
 	oauth1TokenPattern     = regexp.MustCompile(`oauth_token=[^&\s]+`)
@@ -171,6 +185,7 @@ func sanitizeHook(i *cassette.Interaction) error {
 
 	// Anonymize device IDs in URL paths
 	i.Request.URL = deviceSettingsURLPattern.ReplaceAllString(i.Request.URL, "/device-info/settings/12345678")
+	i.Request.URL = solarURLPattern.ReplaceAllString(i.Request.URL, "/web-gateway/solar/12345678")
 
 	// Anonymize displayName/UUID in race predictions URLs
 	i.Request.URL = racePredictionsURLPattern.ReplaceAllString(i.Request.URL, "/racepredictions/$1/anonymous")
@@ -180,6 +195,22 @@ func sanitizeHook(i *cassette.Interaction) error {
 	i.Request.URL = courseFITURLPattern.ReplaceAllString(i.Request.URL, "/course-service/course/fit/87654321")
 	i.Request.URL = courseURLPattern.ReplaceAllString(i.Request.URL, "/course-service/course/87654321")
 
+	// Anonymize user profile PKs in gear URLs
+	i.Request.URL = gearUserURLPattern.ReplaceAllString(i.Request.URL, "/gear-service/gear/user/12345678")
+	i.Request.URL = userProfilePkQueryPattern.ReplaceAllString(i.Request.URL, "userProfilePk=12345678")
+
+	// Anonymize displayName in personal records URLs
+	i.Request.URL = personalRecordsURLPattern.ReplaceAllString(i.Request.URL, "/personalrecord/prs/anonymous")
+
+	// Anonymize displayName in daily summary chart URLs
+	i.Request.URL = dailySummaryChartPattern.ReplaceAllString(i.Request.URL, "/dailySummaryChart/anonymous")
+
+	// Anonymize displayName in daily user summary URLs
+	i.Request.URL = userSummaryDailyPattern.ReplaceAllString(i.Request.URL, "/usersummary/daily/anonymous")
+
+	// Anonymize displayName in wellness user stats URLs
+	i.Request.URL = userStatsWellnessPattern.ReplaceAllString(i.Request.URL, "/userstats-service/wellness/daily/anonymous")
+
 	// Sanitize request body (for login requests)
 	if strings.Contains(i.Request.Body, "password") {
 		i.Request.Body = "[REDACTED]"
@@ -190,6 +221,9 @@ func sanitizeHook(i *cassette.Interaction) error {
 
 	// Anonymize personal information in response body
 	i.Response.Body = anonymizeBody(i.Response.Body)
+	if periodicHealthURLPattern.MatchString(i.Request.URL) {
+		i.Response.Body = anonymizeHealthBody(i.Response.Body)
+	}
 
 	return nil
 }
@@ -237,6 +271,8 @@ func anonymizeBody(body string) string {
 
 	// Device info
 	body = deviceIDPattern.ReplaceAllString(body, `"deviceId":12345678`)
+	body = userDeviceIDPattern.ReplaceAllString(body, `"userDeviceId":12345678`)
+	body = userProfileNumberPattern.ReplaceAllString(body, `"userProfileNumber":12345678`)
 	body = unitIDPattern.ReplaceAllString(body, `"unitId":12345678`)
 	body = serialNumberPattern.ReplaceAllString(body, `"serialNumber":"ABC123456"`)
 
@@ -277,6 +313,69 @@ func anonymizeBody(body string) string {
 	return body
 }
 
+// Sensitive keys of women's health responses, by the value they are replaced with.
+var (
+	healthTextKeys = map[string]bool{"notes": true, "note": true, "babyName": true}
+	healthLogKeys  = map[string]bool{"symptoms": true, "moods": true, "sexualActivity": true, "flowIntensity": true}
+	healthDateKeys = map[string]bool{
+		"startDate": true, "endDate": true, "dueDate": true, "deliveryDate": true,
+		"conceptionDate": true, "lastMenstrualPeriodDate": true,
+	}
+)
+
+// anonymizeHealthBody removes the free text, logged symptoms, flow and cycle and
+// pregnancy dates of women's health responses. The body is decoded and re-encoded
+// so that values of any shape are redacted; a body that is not JSON is dropped.
+func anonymizeHealthBody(body string) string {
+	if strings.TrimSpace(body) == "" {
+		return body
+	}
+
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "[REDACTED]"
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactHealthValue(v)); err != nil {
+		return "[REDACTED]"
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// redactHealthValue replaces the sensitive keys of v, recursing into nested objects and arrays.
+func redactHealthValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			switch {
+			case value == nil:
+			case healthTextKeys[key]:
+				v[key] = "[REDACTED]"
+			case healthLogKeys[key]:
+				if _, ok := value.([]any); ok {
+					v[key] = []any{}
+				} else {
+					v[key] = nil
+				}
+			case healthDateKeys[key]:
+				v[key] = "2000-01-01"
+			default:
+				v[key] = redactHealthValue(value)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactHealthValue(v[i])
+		}
+	}
+	return v
+}
+
 // flexibleMatcher matches requests ignoring volatile headers and query params.
 func flexibleMatcher(r *http.Request, i cassette.Request) bool {
 	// Match on method
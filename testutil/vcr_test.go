@@ -0,0 +1,75 @@
+// testutil/vcr_test.go
+package testutil
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestAnonymizeHealthBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "escaped quotes in notes",
+			body: `{"notes":"felt \"awful\" cramps","dayInCycle":3}`,
+			want: `{"notes":"[REDACTED]","dayInCycle":3}`,
+		},
+		{
+			name: "nested arrays in logs",
+			body: `{"symptoms":[["CRAMPS"],["HEADACHE","BLOATING"]],"moods":["ANXIOUS"],"periodLength":5}`,
+			want: `{"symptoms":[],"moods":[],"periodLength":5}`,
+		},
+		{
+			name: "null and object values",
+			body: `{"symptoms":null,"sexualActivity":{"protected":false},"flowIntensity":"HEAVY","note":null}`,
+			want: `{"symptoms":null,"sexualActivity":null,"flowIntensity":null,"note":null}`,
+		},
+		{
+			name: "cycle and pregnancy dates",
+			body: `{"cycleSummaries":[{"startDate":"2026-01-03","endDate":"2026-01-30","cycleLength":28}],"dueDate":"2026-09-14","babyName":"Alex"}`,
+			want: `{"cycleSummaries":[{"startDate":"2000-01-01","endDate":"2000-01-01","cycleLength":28}],"dueDate":"2000-01-01","babyName":"[REDACTED]"}`,
+		},
+		{
+			name: "nested objects",
+			body: `{"dailyLog":{"calendarDate":"2026-01-27","notes":"private <b>text</b>"}}`,
+			want: `{"dailyLog":{"calendarDate":"2026-01-27","notes":"[REDACTED]"}}`,
+		},
+		{
+			name: "not JSON",
+			body: `<html>felt awful</html>`,
+			want: `[REDACTED]`,
+		},
+		{
+			name: "empty",
+			body: ``,
+			want: ``,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := anonymizeHealthBody(tt.body)
+			if tt.want == "" || tt.want == "[REDACTED]" {
+				if got != tt.want {
+					t.Errorf("anonymizeHealthBody() = %q, want %q", got, tt.want)
+				}
+				return
+			}
+
+			var gotValue, wantValue any
+			if err := json.Unmarshal([]byte(got), &gotValue); err != nil {
+				t.Fatalf("anonymizeHealthBody() returned invalid JSON %q: %v", got, err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &wantValue); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(gotValue, wantValue) {
+				t.Errorf("anonymizeHealthBody() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}